@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/gool-cli/gool/internal/plan"
+	"github.com/gool-cli/gool/internal/upgrade"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+var (
+	addDir    string
+	addDryRun bool
+)
+
+// addCmd represents the add command
+var addCmd = &cobra.Command{
+	Use:   "add",
+	Short: "Add capabilities to an existing project",
+	Long:  `Add capabilities to a project that was generated by gool.`,
+}
+
+// addFeatureCmd represents the add feature command
+var addFeatureCmd = &cobra.Command{
+	Use:   "feature <name>...",
+	Short: "Enable features in an existing project",
+	Long: `Enable features in a project that was generated by gool.
+
+The feature is recorded in .gool.yaml, its new files are written, and the files
+it touches are patched in place:
+
+  • go.mod gains the new requirements
+  • Go files such as internal/app/app.go and pkg/config/config.go are edited
+    along their syntax tree, keeping your own changes
+  • .env.example, docker-compose.yml and other files are merged line by line
+
+Available features: ` + strings.Join(upgrade.AddableFeatures, ", ") + `
+
+✨ Examples:
+  gool add feature metrics
+  gool add feature websocket caching --dir ./my-api
+  gool add feature swagger --dry-run   # Show the changes without writing`,
+	Args:         cobra.MinimumNArgs(1),
+	ValidArgs:    upgrade.AddableFeatures,
+	SilenceUsage: true,
+	RunE:         runAddFeature,
+}
+
+func init() {
+	rootCmd.AddCommand(addCmd)
+	addCmd.AddCommand(addFeatureCmd)
+
+	addFeatureCmd.Flags().StringVar(&addDir, "dir", ".", "Project directory containing .gool.yaml")
+	addFeatureCmd.Flags().BoolVar(&addDryRun, "dry-run", false, "Show the files that would change, with diffs, without writing")
+}
+
+func runAddFeature(cmd *cobra.Command, args []string) error {
+	magenta := color.New(color.FgMagenta, color.Bold)
+	green := color.New(color.FgGreen, color.Bold)
+	yellow := color.New(color.FgYellow)
+
+	fmt.Println()
+	magenta.Printf("➕ Adding %s...\n", strings.Join(args, ", "))
+	fmt.Println()
+
+	root, err := filepath.Abs(addDir)
+	if err != nil {
+		return fmt.Errorf("failed to resolve %s: %w", addDir, err)
+	}
+
+	// A dry run reads the project from disk but keeps every write in memory
+	disk := afero.NewOsFs()
+	fs, layer := disk, afero.NewMemMapFs()
+	if addDryRun {
+		fs = afero.NewCopyOnWriteFs(afero.NewReadOnlyFs(disk), layer)
+	}
+
+	report, err := upgrade.AddFeatures(fs, root, args)
+	if err != nil {
+		color.Red("❌ Failed to add feature: %v", err)
+		return err
+	}
+
+	printFileResults(report)
+
+	if addDryRun {
+		files, err := plan.Build(layer, disk, root)
+		if err != nil {
+			color.Red("❌ Failed to plan changes: %v", err)
+			return err
+		}
+		printPlan(filepath.Base(root), files)
+		return nil
+	}
+
+	if conflicts := report.Count(upgrade.StatusConflict); conflicts > 0 {
+		yellow.Println("💡 Resolve the conflict markers (<<<<<<< yours ... >>>>>>> gool) and review the changes.")
+		return fmt.Errorf("%d file(s) have merge conflicts", conflicts)
+	}
+
+	green.Println("🎉 Feature added successfully!")
+	yellow.Println("💡 Run 'go mod tidy' to download the new dependencies.")
+
+	return nil
+}
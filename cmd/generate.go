@@ -0,0 +1,186 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/gool-cli/gool/internal/config"
+	"github.com/gool-cli/gool/internal/generator"
+	"github.com/gool-cli/gool/internal/manifest"
+	"github.com/gool-cli/gool/internal/plan"
+	"github.com/gool-cli/gool/internal/resource"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+var (
+	generateDir    string
+	generateDryRun bool
+)
+
+// generateCmd represents the generate command
+var generateCmd = &cobra.Command{
+	Use:     "generate",
+	Aliases: []string{"g"},
+	Short:   "Generate code in an existing project",
+	Long:    `Generate code in a project that was generated by gool.`,
+}
+
+// generateResourceCmd represents the generate resource command
+var generateResourceCmd = &cobra.Command{
+	Use:   "resource <Name> <field:type[:modifier]>...",
+	Short: "Generate a CRUD resource",
+	Long: `Generate a complete CRUD slice for a resource in a project that was generated
+by gool: the model, a repository for the project's ORM, a service, a handler
+for the project's framework with Swagger annotations, and table-driven handler
+tests. Files are placed according to the project's architecture and the
+routes are registered in api/routes/routes.go, behind the middleware guarding
+the project's users routes.
+
+Field types: ` + strings.Join(resource.Types(), ", ") + `
+Modifiers:   ` + strings.Join(resource.Modifiers(), ", ") + `
+
+✨ Examples:
+  gool generate resource Product name:string:required price:float64 sku:string:unique
+  gool g resource OrderItem order_id:uint:index quantity:int --dir ./my-api
+  gool g resource Tag name:string --dry-run   # Show the changes without writing`,
+	Args:         cobra.MinimumNArgs(2),
+	SilenceUsage: true,
+	RunE:         runGenerateResource,
+}
+
+func init() {
+	rootCmd.AddCommand(generateCmd)
+	generateCmd.AddCommand(generateResourceCmd)
+
+	generateResourceCmd.Flags().StringVar(&generateDir, "dir", ".", "Project directory containing .gool.yaml")
+	generateResourceCmd.Flags().BoolVar(&generateDryRun, "dry-run", false, "Show the files that would be written, with diffs, without writing")
+}
+
+func runGenerateResource(cmd *cobra.Command, args []string) error {
+	magenta := color.New(color.FgMagenta, color.Bold)
+	green := color.New(color.FgGreen, color.Bold)
+	yellow := color.New(color.FgYellow)
+
+	res, err := resource.Parse(args[0], args[1:])
+	if err != nil {
+		color.Red("❌ Invalid resource: %v", err)
+		return err
+	}
+
+	root, err := filepath.Abs(generateDir)
+	if err != nil {
+		return fmt.Errorf("failed to resolve %s: %w", generateDir, err)
+	}
+
+	// A dry run reads the project from disk but keeps every write in memory
+	disk := afero.NewOsFs()
+	fs, layer := disk, afero.NewMemMapFs()
+	if generateDryRun {
+		fs = afero.NewCopyOnWriteFs(afero.NewReadOnlyFs(disk), layer)
+	}
+
+	m, err := manifest.Load(fs, root)
+	if err != nil {
+		color.Red("❌ %v", err)
+		return err
+	}
+	if m.HasResource(res.Name) {
+		err := fmt.Errorf("resource %s already exists", res.Name)
+		color.Red("❌ %v", err)
+		return err
+	}
+
+	fmt.Println()
+	magenta.Printf("🧱 Generating resource %s...\n", res.Name)
+	fmt.Println()
+
+	written, err := generator.NewFs(fs).GenerateResource(&m.Config, root, res)
+	if err != nil {
+		color.Red("❌ Failed to generate resource: %v", err)
+		return err
+	}
+
+	// Record the new files and the patched routes, so that upgrades merge
+	// them like the rest of the project
+	patched := make(map[string]bool)
+	for _, path := range written {
+		_, patched[path] = m.Files[path]
+	}
+	m.Resources = append(m.Resources, manifest.Resource{Name: res.Name, Fields: res.Specs()})
+	if err := m.Record(fs, root, written); err != nil {
+		color.Red("❌ %v", err)
+		return err
+	}
+	if err := m.Write(fs, root); err != nil {
+		color.Red("❌ %v", err)
+		return err
+	}
+
+	if generateDryRun {
+		files, err := plan.Build(layer, disk, root)
+		if err != nil {
+			color.Red("❌ Failed to plan resource: %v", err)
+			return err
+		}
+		printPlan(filepath.Base(root), files)
+		return nil
+	}
+
+	layout := generator.LayoutFor(&m.Config)
+	for _, path := range written {
+		if patched[path] {
+			color.Cyan("  ✏️  %s", path)
+		} else {
+			color.Green("  ✅ %s", path)
+		}
+	}
+	fmt.Println()
+
+	green.Printf("🎉 Resource %s generated at /api/v1/%s\n", res.Name, res.Path())
+	switch {
+	case m.Config.Database == config.DBMongoDB:
+		yellow.Printf("💡 The %s collection is created on first write and its indexes when the server starts.\n", res.Table())
+	case m.Config.Database == config.DBRedis:
+		yellow.Printf("💡 Redis keeps %s in the %s hash.\n", res.Label(), res.Table())
+	case m.Config.ORM == config.ORMNone:
+		yellow.Printf("💡 The in-memory store keeps %s until the server stops.\n", res.Label())
+	case m.Config.Features.Migrations:
+		yellow.Printf("💡 Run 'go run . migrate up' to create the %s table.\n", res.Table())
+	case m.Config.ORM == config.ORMGorm:
+		yellow.Printf("💡 Add &%s{} to AutoMigrate in pkg/database/database.go to create the %s table.\n",
+			layout.Model.Ref()+"."+res.Name, res.Table())
+	case m.Config.ORM == config.ORMEnt:
+		yellow.Printf("💡 The %s table is created from its schema when the server starts.\n", res.Table())
+	case m.Config.ORM == config.ORMSqlx || m.Config.ORM == config.ORMRaw || m.Config.ORM == config.ORMBun:
+		yellow.Printf("💡 Create the %s table before starting the server.\n", res.Table())
+	}
+	if m.Config.Features.RBAC {
+		policy := "config/rbac." + m.Config.Config
+		if m.Config.Features.Casbin {
+			policy += " or config/rbac_policy.csv"
+		}
+		yellow.Printf("💡 Its routes take the %[1]s:read, %[1]s:write and %[1]s:delete permissions, granted to admins. Grant them to other roles in %[2]s.\n",
+			res.Table(), policy)
+	}
+	if m.Config.UsesAPIKeys() {
+		read, write := generator.ResourceScopes(res)
+		yellow.Printf("💡 API keys need the %s scope to read %s and %s to change them.\n", read, res.Label(), write)
+		if m.Config.ORM != config.ORMNone {
+			yellow.Printf("   go run . keys create -name NAME -scopes %s,%s\n", read, write)
+		}
+	}
+	if m.Config.ORM == config.ORMSqlc {
+		yellow.Printf("💡 Run 'sqlc generate' after editing queries/%s.sql.\n", res.Table())
+	}
+	if m.Config.ORM == config.ORMEnt {
+		yellow.Println("💡 Run 'go generate ./ent' to add the schema to the ent client.")
+	}
+	if m.Config.Features.Swagger {
+		yellow.Println("💡 Run 'swag init' to refresh the API docs.")
+	}
+
+	return nil
+}
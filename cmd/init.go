@@ -3,24 +3,48 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/fatih/color"
+	"github.com/gool-cli/gool/internal/archive"
 	"github.com/gool-cli/gool/internal/config"
 	"github.com/gool-cli/gool/internal/generator"
+	"github.com/gool-cli/gool/internal/plan"
 	"github.com/gool-cli/gool/internal/prompts"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 )
 
 var (
-	projectName string
-	interactive bool
-	framework   string
-	orm         string
-	database    string
-	arch        string
+	projectName  string
+	interactive  bool
+	framework    string
+	orm          string
+	database     string
+	arch         string
+	modulePath   string
+	auth         string
+	logging      string
+	configFormat string
+	cicd         string
+	features     []string
+	middleware   []string
+	noDocker     bool
+	noTests      bool
+	specFile     string
+	layoutFile   string
+	dryRun       bool
+	archivePath  string
 )
 
+// configFlags are the flags that switch init into non-interactive mode
+var configFlags = []string{
+	"framework", "orm", "database", "arch", "module", "auth", "logging",
+	"config-format", "cicd", "features", "middleware", "no-docker", "no-tests",
+	"layout",
+}
+
 // initCmd represents the init command
 var initCmd = &cobra.Command{
 	Use:   "init [project-name]",
@@ -37,7 +61,18 @@ var initCmd = &cobra.Command{
 ✨ Examples:
   gool init my-api --framework=gin --database=postgresql
   gool init my-service --arch=clean --interactive=false
-  gool init my-microservice --framework=echo --orm=sqlx`,
+  gool init my-microservice --framework=echo --orm=sqlx
+  gool init my-api --module=github.com/acme/my-api --auth=none --logging=zap \
+    --features=websocket,metrics --middleware=cors,ratelimit --no-docker
+  gool init my-api --layout=layout.yaml  # Custom architecture from a layout file
+
+📄 Spec Mode:
+  gool init --from gool.yaml           # Generate from a declarative spec
+  gool init my-api --from spec.json    # Override the spec's project name
+
+🔍 Preview and Packaging:
+  gool init my-api --dry-run           # Show the files and diffs without writing
+  gool init my-api --archive my-api.zip  # Write the project to a .zip or .tar.gz`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: runInit,
 }
@@ -47,10 +82,25 @@ func init() {
 
 	// Flags for non-interactive mode
 	initCmd.Flags().StringVarP(&framework, "framework", "f", "", "Web framework (gin, echo, fiber, revel)")
-	initCmd.Flags().StringVarP(&orm, "orm", "o", "", "ORM/Database layer (gorm, sqlx, raw, none)")
+	initCmd.Flags().StringVarP(&orm, "orm", "o", "", "ORM/Database layer ("+strings.Join(config.ORMNames(), ", ")+")")
 	initCmd.Flags().StringVarP(&database, "database", "d", "", "Database type (postgresql, mysql, sqlite, mongodb, redis, memory)")
 	initCmd.Flags().StringVarP(&arch, "arch", "a", "", "Architecture (simple, clean, hexagonal, mvc, custom)")
+	initCmd.Flags().StringVarP(&modulePath, "module", "m", "", "Go module path (default: github.com/username/<project-name>)")
+	initCmd.Flags().StringVar(&auth, "auth", "", "Authentication method (jwt, oauth2, basic, apikey, none)")
+	initCmd.Flags().StringVar(&logging, "logging", "", "Logging library (zap, logrus, charm, standard)")
+	initCmd.Flags().StringVar(&configFormat, "config-format", "", "Configuration format (yaml, json, toml)")
+	initCmd.Flags().StringVar(&cicd, "cicd", "", "CI/CD platform (github, gitlab, none)")
+	initCmd.Flags().StringSliceVar(&features, "features", config.DefaultFeatures,
+		"Features to enable ("+strings.Join(config.FeatureNames, ", ")+")")
+	initCmd.Flags().StringSliceVar(&middleware, "middleware", config.DefaultMiddleware,
+		"Middleware to enable ("+strings.Join(config.MiddlewareNames, ", ")+")")
+	initCmd.Flags().BoolVar(&noDocker, "no-docker", false, "Skip Dockerfile and docker-compose.yml")
+	initCmd.Flags().BoolVar(&noTests, "no-tests", false, "Skip test templates and examples")
+	initCmd.Flags().StringVar(&layoutFile, "layout", "", "Custom architecture layout file (yaml) declaring packages, roles and allowed imports")
+	initCmd.Flags().StringVar(&specFile, "from", "", "Generate from a project spec file (yaml, json, toml)")
 	initCmd.Flags().BoolVar(&interactive, "interactive", true, "Run in interactive mode (default: true)")
+	initCmd.Flags().BoolVar(&dryRun, "dry-run", false, "Show the files that would be generated, with diffs against existing files, without writing")
+	initCmd.Flags().StringVar(&archivePath, "archive", "", "Write the project to a .zip or .tar.gz archive instead of a directory")
 }
 
 func runInit(cmd *cobra.Command, args []string) error {
@@ -59,13 +109,42 @@ func runInit(cmd *cobra.Command, args []string) error {
 		projectName = args[0]
 	}
 
+	if archivePath != "" && !archive.Supported(archivePath) {
+		err := fmt.Errorf("unsupported archive '%s'. Use a .zip, .tar.gz or .tgz file", archivePath)
+		color.Red("❌ Configuration error: %v", err)
+		return err
+	}
+
 	var cfg *config.ProjectConfig
 	var err error
 
 	// Check if user wants non-interactive mode by providing flags
-	isNonInteractive := !interactive || (framework != "" || orm != "" || database != "" || arch != "")
+	flagsChanged := false
+	for _, name := range configFlags {
+		if cmd.Flags().Changed(name) {
+			flagsChanged = true
+		}
+	}
+	isNonInteractive := !interactive || flagsChanged
+
+	if specFile != "" {
+		// Spec mode: everything comes from the spec file
+		if flagsChanged {
+			err := fmt.Errorf("--from cannot be combined with other configuration flags")
+			color.Red("❌ Configuration error: %v", err)
+			return err
+		}
 
-	if !isNonInteractive {
+		cfg, err = loadSpec(specFile)
+		if err != nil {
+			color.Red("❌ Configuration error: %v", err)
+			printConfigurationHelp()
+			return err
+		}
+
+		// Show quick setup summary
+		printQuickSetupSummary(cfg)
+	} else if !isNonInteractive {
 		// Interactive mode (default)
 		cfg, err = prompts.CollectProjectConfig()
 		if err != nil {
@@ -84,10 +163,25 @@ func runInit(cmd *cobra.Command, args []string) error {
 
 		cfg = &config.ProjectConfig{
 			ProjectName:  projectName,
+			ModulePath:   modulePath,
 			Framework:    framework,
 			ORM:          orm,
 			Database:     database,
 			Architecture: arch,
+			Config:       configFormat,
+			Auth:         auth,
+			Logging:      logging,
+			Testing:      !noTests,
+			Docker:       !noDocker,
+			CICD:         cicd,
+		}
+
+		if layoutFile != "" {
+			cfg.Layout, err = config.LoadLayout(layoutFile)
+			if err != nil {
+				color.Red("❌ Configuration error: %v", err)
+				return err
+			}
 		}
 
 		// Validate and set defaults for missing values
@@ -97,6 +191,13 @@ func runInit(cmd *cobra.Command, args []string) error {
 			return err
 		}
 
+		// Enable the requested features and middleware
+		if err := applyFeatureFlags(cfg, features, middleware); err != nil {
+			color.Red("❌ Configuration error: %v", err)
+			printConfigurationHelp()
+			return err
+		}
+
 		// Show quick setup summary
 		printQuickSetupSummary(cfg)
 	}
@@ -108,15 +209,23 @@ func runInit(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	// Check if project directory already exists
 	projectPath := prompts.GetProjectPath(cfg.ProjectName)
-	if _, err := os.Stat(projectPath); !os.IsNotExist(err) {
+
+	// Check if project directory already exists. A dry run checks too, so it
+	// never plans a project the real run would refuse; only an archive is
+	// written elsewhere.
+	if _, err := os.Stat(projectPath); !os.IsNotExist(err) && (dryRun || archivePath == "") {
 		color.Red("❌ Directory '%s' already exists", projectPath)
 		color.Yellow("💡 Try using a different project name or remove the existing directory:")
 		color.White("   rm -rf %s", projectPath)
 		return fmt.Errorf("directory '%s' already exists", projectPath)
 	}
 
+	// Dry runs and archives are rendered in memory and leave the directory alone
+	if dryRun || archivePath != "" {
+		return generateInMemory(cfg, projectPath)
+	}
+
 	// Generate the project
 	printGenerationStart(cfg)
 
@@ -144,6 +253,46 @@ func runInit(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// generateInMemory renders the project into memory, then previews it for
+// --dry-run and packs it for --archive
+func generateInMemory(cfg *config.ProjectConfig, projectPath string) error {
+	root, err := filepath.Abs(projectPath)
+	if err != nil {
+		return fmt.Errorf("failed to resolve %s: %w", projectPath, err)
+	}
+
+	fs := afero.NewMemMapFs()
+	if err := generator.NewFs(fs).GenerateAt(cfg, root); err != nil {
+		color.Red("❌ Failed to generate project: %v", err)
+		printErrorHelp(err)
+		return fmt.Errorf("failed to generate project: %w", err)
+	}
+
+	if dryRun {
+		files, err := plan.Build(fs, afero.NewOsFs(), root)
+		if err != nil {
+			color.Red("❌ Failed to plan project: %v", err)
+			return err
+		}
+
+		fmt.Println()
+		color.New(color.FgMagenta, color.Bold).Printf("📋 Planned files for %s\n", cfg.ProjectName)
+		fmt.Println()
+		printPlan(cfg.ProjectName, files)
+	}
+
+	if archivePath != "" {
+		if err := archive.Write(fs, root, cfg.ProjectName, archivePath); err != nil {
+			color.Red("❌ Failed to archive project: %v", err)
+			return err
+		}
+		fmt.Println()
+		color.New(color.FgGreen, color.Bold).Printf("📦 Project '%s' archived to %s\n", cfg.ProjectName, archivePath)
+	}
+
+	return nil
+}
+
 // validateAndSetDefaults validates configuration and sets defaults
 func validateAndSetDefaults(cfg *config.ProjectConfig) error {
 	// Set defaults for missing values
@@ -162,10 +311,14 @@ func validateAndSetDefaults(cfg *config.ProjectConfig) error {
 		return fmt.Errorf("invalid framework '%s'. Valid options: gin, echo, fiber, revel", cfg.Framework)
 	}
 
-	if cfg.ORM == "" {
+	if cfg.ORM == "" && (cfg.Database == config.DBMongoDB || cfg.Database == config.DBRedis) {
+		cfg.ORM = config.ORMRaw
+	} else if cfg.ORM == "" && cfg.Database == config.DBMemory {
+		cfg.ORM = config.ORMNone
+	} else if cfg.ORM == "" {
 		cfg.ORM = config.ORMGorm
 	} else if !isValidORM(cfg.ORM) {
-		return fmt.Errorf("invalid ORM '%s'. Valid options: gorm, sqlx, raw, none", cfg.ORM)
+		return fmt.Errorf("invalid ORM '%s'. Valid options: %s", cfg.ORM, strings.Join(config.ORMNames(), ", "))
 	}
 
 	if cfg.Database == "" && cfg.ORM != config.ORMNone {
@@ -174,29 +327,99 @@ func validateAndSetDefaults(cfg *config.ProjectConfig) error {
 		return fmt.Errorf("invalid database '%s'. Valid options: postgresql, mysql, sqlite, mongodb, redis, memory", cfg.Database)
 	}
 
-	if cfg.Architecture == "" {
+	if cfg.Architecture == "" && cfg.Layout != nil {
+		cfg.Architecture = config.ArchCustom
+	} else if cfg.Architecture == "" {
 		cfg.Architecture = config.ArchSimple
 	} else if !isValidArchitecture(cfg.Architecture) {
 		return fmt.Errorf("invalid architecture '%s'. Valid options: simple, clean, hexagonal, mvc, custom", cfg.Architecture)
 	}
 
-	// Set module path
-	cfg.ModulePath = fmt.Sprintf("github.com/username/%s", cfg.ProjectName)
-	cfg.Config = config.ConfigYAML
-	cfg.Auth = config.AuthJWT
-	cfg.Logging = config.LogZap
-	cfg.Testing = true
-	cfg.Docker = true
-	cfg.CICD = config.CICDGitHub
+	if cfg.Layout != nil {
+		if cfg.Architecture != config.ArchCustom {
+			return fmt.Errorf("a layout can only be used with the custom architecture, not '%s'", cfg.Architecture)
+		}
+		if err := cfg.Layout.Validate(); err != nil {
+			return fmt.Errorf("invalid layout: %w", err)
+		}
+	}
+
+	if cfg.ModulePath == "" {
+		cfg.ModulePath = fmt.Sprintf("github.com/username/%s", cfg.ProjectName)
+	} else if !isValidModulePath(cfg.ModulePath) {
+		return fmt.Errorf("invalid module path '%s'. Use a path like github.com/username/project", cfg.ModulePath)
+	}
+
+	if cfg.Config == "" {
+		cfg.Config = config.ConfigYAML
+	} else if !isValidConfigFormat(cfg.Config) {
+		return fmt.Errorf("invalid config format '%s'. Valid options: yaml, json, toml", cfg.Config)
+	}
+
+	if cfg.Auth == "" {
+		cfg.Auth = config.AuthJWT
+	} else if !isValidAuth(cfg.Auth) {
+		return fmt.Errorf("invalid auth method '%s'. Valid options: jwt, oauth2, basic, apikey, none", cfg.Auth)
+	}
 
-	// Enable common middleware
-	cfg.Middleware.CORS = true
-	cfg.Middleware.Logging = true
-	cfg.Middleware.ErrorHandler = true
+	if cfg.Logging == "" {
+		cfg.Logging = config.LogZap
+	} else if !isValidLogging(cfg.Logging) {
+		return fmt.Errorf("invalid logging library '%s'. Valid options: zap, logrus, charm, standard", cfg.Logging)
+	}
+
+	if cfg.CICD == "" {
+		cfg.CICD = config.CICDGitHub
+	} else if !isValidCICD(cfg.CICD) {
+		return fmt.Errorf("invalid CI/CD platform '%s'. Valid options: github, gitlab, none", cfg.CICD)
+	}
+
+	return nil
+}
+
+// loadSpec reads a project spec file and fills in defaults for missing values
+func loadSpec(path string) (*config.ProjectConfig, error) {
+	color.Cyan("📄 Spec Mode: %s", path)
+	fmt.Println()
+
+	cfg, err := config.LoadProjectConfig(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// The project name argument takes precedence over the spec
+	if projectName != "" {
+		cfg.ProjectName = projectName
+	}
+
+	if err := validateAndSetDefaults(cfg); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// applyFeatureFlags enables the named features and middleware
+func applyFeatureFlags(cfg *config.ProjectConfig, featureNames, middlewareNames []string) error {
+	cfg.Features = config.FeaturesConfig{}
+	for _, name := range featureNames {
+		if strings.TrimSpace(name) == "" {
+			continue
+		}
+		if err := cfg.Features.Enable(name); err != nil {
+			return err
+		}
+	}
 
-	// Enable common features
-	cfg.Features.HealthCheck = true
-	cfg.Features.Swagger = true
+	cfg.Middleware = config.MiddlewareConfig{}
+	for _, name := range middlewareNames {
+		if strings.TrimSpace(name) == "" {
+			continue
+		}
+		if err := cfg.Middleware.Enable(name); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -212,14 +435,101 @@ func validateProjectConfig(cfg *config.ProjectConfig) error {
 	}
 
 	// Check ORM and Database compatibility
-	if cfg.ORM == config.ORMNone && cfg.Database != "" {
+	if cfg.ORM == config.ORMNone && cfg.Database != "" && cfg.Database != config.DBMemory {
 		color.Yellow("⚠️  Warning: Database '%s' specified but ORM is 'none'. Database will be ignored.", cfg.Database)
 		cfg.Database = ""
 	}
+	switch cfg.Database {
+	case config.DBMongoDB, config.DBRedis:
+		if cfg.ORM != config.ORMRaw {
+			return fmt.Errorf("%s is accessed with its own driver, not '%s'. Use --orm raw with --database %s", cfg.Database, cfg.ORM, cfg.Database)
+		}
+	case config.DBMemory:
+		if cfg.ORM != config.ORMNone {
+			return fmt.Errorf("the in-memory store does not use an ORM, not '%s'. Use --orm none with --database memory", cfg.ORM)
+		}
+	}
+
+	if orm, _ := config.LookupORM(cfg.ORM); cfg.Database != "" && !orm.Supports(cfg.Database) {
+		return fmt.Errorf("%s supports %s, not '%s'", cfg.ORM, strings.Join(orm.Databases, ", "), cfg.Database)
+	}
+
+	// sqlc compiles its queries against the schema the migrations build
+	if cfg.ORM == config.ORMSqlc {
+		cfg.Features.Migrations = true
+	}
+
+	// Revel projects keep revel's own app/controllers layout, and only the
+	// features its controllers and interceptors serve
+	if cfg.Framework == config.FrameworkRevel {
+		if cfg.Architecture != config.ArchSimple {
+			return fmt.Errorf("revel projects use revel's own app/controllers layout, not the %s architecture. Use --arch simple", cfg.Architecture)
+		}
+		if skipped := dropRevelFeatures(&cfg.Features); len(skipped) > 0 {
+			color.Yellow("⚠️  Warning: revel projects are generated without %s. They will be skipped.", strings.Join(skipped, ", "))
+		}
+	}
+
+	// OAuth2, Basic auth and API keys are served through the gin, echo and
+	// fiber routers and middleware. Revel signs users in with JWT.
+	if cfg.Framework == config.FrameworkRevel && cfg.Auth != config.AuthJWT && cfg.Auth != config.AuthNone {
+		return fmt.Errorf("%s auth is generated for gin, echo and fiber, not revel. Use --auth jwt or none", cfg.Auth)
+	}
+
+	// Migrations are SQL files run by the generated binary, or when a revel
+	// app starts
+	sqlDatabase := cfg.Database == config.DBPostgreSQL || cfg.Database == config.DBMySQL || cfg.Database == config.DBSQLite
+	if cfg.Features.Migrations && !sqlDatabase {
+		color.Yellow("⚠️  Warning: Migrations need a SQL database. They will be skipped.")
+		cfg.Features.Migrations = false
+	}
+
+	// sqlx and raw projects create tables only through the migrations, and
+	// the auth methods and revel's Users controller keep their users and API
+	// keys in tables
+	if (cfg.ORM == config.ORMSqlx || cfg.ORM == config.ORMRaw) && sqlDatabase && (cfg.StoresUsers() || cfg.UsesAPIKeys() || cfg.Framework == config.FrameworkRevel) {
+		cfg.Features.Migrations = true
+	}
+
+	// Casbin is a backend of the RBAC package, whose roles are stored on the
+	// users Basic auth and JWT sign in
+	if cfg.Features.Casbin {
+		cfg.Features.RBAC = true
+	}
+	if cfg.Features.RBAC && !cfg.StoresUsers() {
+		color.Yellow("⚠️  Warning: RBAC needs --auth basic or jwt and a gin, echo or fiber project. It will be skipped.")
+		cfg.Features.RBAC = false
+		cfg.Features.Casbin = false
+	}
 
 	return nil
 }
 
+// dropRevelFeatures turns off the features revel projects are generated
+// without, returning the names of those that were on
+func dropRevelFeatures(features *config.FeaturesConfig) []string {
+	var skipped []string
+	for _, feature := range []struct {
+		name string
+		on   *bool
+	}{
+		{"websocket", &features.WebSocket},
+		{"caching", &features.Caching},
+		{"swagger", &features.Swagger},
+		{"static", &features.StaticFiles},
+		{"i18n", &features.I18n},
+		{"metrics", &features.Metrics},
+		{"rbac", &features.RBAC},
+		{"casbin", &features.Casbin},
+	} {
+		if *feature.on {
+			skipped = append(skipped, feature.name)
+			*feature.on = false
+		}
+	}
+	return skipped
+}
+
 // Validation helper functions
 func isValidProjectName(name string) bool {
 	if name == "" {
@@ -245,13 +555,8 @@ func isValidFramework(framework string) bool {
 }
 
 func isValidORM(orm string) bool {
-	validORMs := []string{config.ORMGorm, config.ORMSqlx, config.ORMRaw, config.ORMNone}
-	for _, valid := range validORMs {
-		if orm == valid {
-			return true
-		}
-	}
-	return false
+	_, ok := config.LookupORM(orm)
+	return ok
 }
 
 func isValidDatabase(database string) bool {
@@ -264,6 +569,58 @@ func isValidDatabase(database string) bool {
 	return false
 }
 
+func isValidModulePath(path string) bool {
+	if path == "" || strings.HasPrefix(path, "/") || strings.HasSuffix(path, "/") {
+		return false
+	}
+	for _, char := range path {
+		if char == ' ' || char == '\t' || char == '\\' || char == ':' {
+			return false
+		}
+	}
+	return true
+}
+
+func isValidConfigFormat(format string) bool {
+	validFormats := []string{config.ConfigYAML, config.ConfigJSON, config.ConfigTOML}
+	for _, valid := range validFormats {
+		if format == valid {
+			return true
+		}
+	}
+	return false
+}
+
+func isValidAuth(auth string) bool {
+	validAuths := []string{config.AuthJWT, config.AuthOAuth2, config.AuthBasic, config.AuthAPIKey, config.AuthNone}
+	for _, valid := range validAuths {
+		if auth == valid {
+			return true
+		}
+	}
+	return false
+}
+
+func isValidLogging(logging string) bool {
+	validLoggers := []string{config.LogZap, config.LogLogrus, config.LogCharm, config.LogStandard}
+	for _, valid := range validLoggers {
+		if logging == valid {
+			return true
+		}
+	}
+	return false
+}
+
+func isValidCICD(cicd string) bool {
+	validCICDs := []string{config.CICDGitHub, config.CICDGitLab, config.CICDNone}
+	for _, valid := range validCICDs {
+		if cicd == valid {
+			return true
+		}
+	}
+	return false
+}
+
 func isValidArchitecture(arch string) bool {
 	validArchs := []string{config.ArchSimple, config.ArchClean, config.ArchHexagonal, config.ArchMVC, config.ArchCustom}
 	for _, valid := range validArchs {
@@ -324,7 +681,7 @@ func printConfigurationHelp() {
 	fmt.Println()
 
 	cyan.Println("Valid ORMs:")
-	white.Println("  gorm, sqlx, raw, none")
+	white.Printf("  %s\n", strings.Join(config.ORMNames(), ", "))
 	fmt.Println()
 
 	cyan.Println("Valid Databases:")
@@ -335,6 +692,30 @@ func printConfigurationHelp() {
 	white.Println("  simple, clean, hexagonal, mvc, custom")
 	fmt.Println()
 
+	cyan.Println("Valid Config Formats:")
+	white.Println("  yaml, json, toml")
+	fmt.Println()
+
+	cyan.Println("Valid Auth Methods:")
+	white.Println("  jwt, oauth2, basic, apikey, none")
+	fmt.Println()
+
+	cyan.Println("Valid Logging Libraries:")
+	white.Println("  zap, logrus, charm, standard")
+	fmt.Println()
+
+	cyan.Println("Valid CI/CD Platforms:")
+	white.Println("  github, gitlab, none")
+	fmt.Println()
+
+	cyan.Println("Valid Features:")
+	white.Printf("  %s\n", strings.Join(config.FeatureNames, ", "))
+	fmt.Println()
+
+	cyan.Println("Valid Middleware:")
+	white.Printf("  %s\n", strings.Join(config.MiddlewareNames, ", "))
+	fmt.Println()
+
 	yellow.Println("💡 Examples:")
 	white.Println("  gool init my-app --framework=gin --database=postgresql")
 	white.Println("  gool init my-service --arch=clean --orm=gorm")
@@ -355,6 +736,12 @@ func printQuickSetupSummary(cfg *config.ProjectConfig) {
 		yellow.Printf("  • Database: %s\n", cfg.Database)
 	}
 	yellow.Printf("  • Architecture: %s\n", cfg.Architecture)
+	yellow.Printf("  • Module: %s\n", cfg.ModulePath)
+	yellow.Printf("  • Auth: %s\n", cfg.Auth)
+	yellow.Printf("  • Logging: %s\n", cfg.Logging)
+	yellow.Printf("  • Config: %s\n", cfg.Config)
+	yellow.Printf("  • CI/CD: %s\n", cfg.CICD)
+	yellow.Printf("  • Docker: %t, Tests: %t\n", cfg.Docker, cfg.Testing)
 	fmt.Println()
 }
 
@@ -398,8 +785,28 @@ func printSuccessMessage(cfg *config.ProjectConfig, projectPath string) {
 
 	yellow.Println("🚀 Next steps:")
 	white.Printf("  cd %s\n", cfg.ProjectName)
+	if cfg.ORM == config.ORMEnt {
+		// The packages ent generates are imported before they exist, so
+		// the first tidy has to tolerate them
+		white.Printf("  go mod tidy -e\n")
+		white.Printf("  go generate ./ent\n")
+	}
 	white.Printf("  go mod tidy\n")
-	white.Printf("  go run main.go\n")
+	if cfg.Auth == config.AuthOAuth2 {
+		white.Printf("  # set OAUTH2_CLIENT_ID, OAUTH2_CLIENT_SECRET and OAUTH2_ISSUER_URL in .env\n")
+	}
+	if cfg.Features.Migrations && cfg.Framework != config.FrameworkRevel {
+		white.Printf("  go run . migrate up\n")
+	}
+	if cfg.UsesAPIKeys() && cfg.ORM != config.ORMNone {
+		white.Printf("  go run . keys create -name my-service -scopes users:read   # prints an API key\n")
+	}
+	if cfg.Framework == config.FrameworkRevel {
+		white.Printf("  go install github.com/revel/cmd/revel@latest\n")
+		white.Printf("  revel run -a .\n")
+	} else {
+		white.Printf("  go run main.go\n")
+	}
 	fmt.Println()
 
 	if cfg.Features.Swagger {
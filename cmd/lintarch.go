@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/fatih/color"
+	"github.com/gool-cli/gool/internal/archlint"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+var (
+	lintArchDir    string
+	lintArchFormat string
+)
+
+// lintArchCmd represents the lint-arch command
+var lintArchCmd = &cobra.Command{
+	Use:   "lint-arch",
+	Short: "Check that imports follow the project's architecture",
+	Long: `Check every Go package of a generated project against the layering rules of
+its architecture, read from .gool.yaml, and report each import that breaks them.
+
+  • clean: entity ← repository ← usecase ← delivery/http
+  • hexagonal: the domain and the adapters only meet through the ports
+  • mvc and simple: models ← repositories ← services ← controllers/handlers
+  • custom: the imports allowed by the project's layout file
+
+Models, repositories and services must not import a web framework. The
+command exits with an error when it finds a violation, so it can gate CI.
+
+✨ Examples:
+  gool lint-arch                     # Check the project in the current directory
+  gool lint-arch --dir ./my-api      # Check another project
+  gool lint-arch --format json       # Machine-readable report for CI`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE:         runLintArch,
+}
+
+func init() {
+	rootCmd.AddCommand(lintArchCmd)
+
+	lintArchCmd.Flags().StringVar(&lintArchDir, "dir", ".", "Project directory containing .gool.yaml")
+	lintArchCmd.Flags().StringVar(&lintArchFormat, "format", "text", "Output format (text, json)")
+}
+
+func runLintArch(cmd *cobra.Command, args []string) error {
+	if lintArchFormat != "text" && lintArchFormat != "json" {
+		err := fmt.Errorf("invalid format '%s'. Valid options: text, json", lintArchFormat)
+		color.Red("❌ %v", err)
+		return err
+	}
+
+	report, err := archlint.Run(afero.NewOsFs(), lintArchDir)
+	if err != nil {
+		if lintArchFormat == "text" {
+			color.Red("❌ Architecture check failed: %v", err)
+		}
+		return err
+	}
+
+	if lintArchFormat == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(report); err != nil {
+			return fmt.Errorf("failed to write report: %w", err)
+		}
+	} else {
+		printLintArchReport(report)
+	}
+
+	if len(report.Violations) > 0 {
+		return fmt.Errorf("%d architecture violation(s) found", len(report.Violations))
+	}
+	return nil
+}
+
+func printLintArchReport(report *archlint.Report) {
+	cyan := color.New(color.FgCyan, color.Bold)
+	green := color.New(color.FgGreen, color.Bold)
+	red := color.New(color.FgRed, color.Bold)
+	yellow := color.New(color.FgYellow)
+
+	fmt.Println()
+	cyan.Printf("🏛️  Checking %s architecture rules...\n", report.Architecture)
+	fmt.Println()
+
+	for _, violation := range report.Violations {
+		red.Printf("  ✖ %s:%d", violation.File, violation.Line)
+		fmt.Printf(" %s (%s)\n", violation.Message, violation.Import)
+	}
+	if len(report.Violations) > 0 {
+		fmt.Println()
+		red.Printf("❌ %d violation(s) in %d checked file(s)\n", len(report.Violations), report.Files)
+		yellow.Println("💡 Move the code to a layer that may depend on it, or depend on an interface instead.")
+		return
+	}
+
+	green.Printf("✅ No violations in %d checked file(s)\n", report.Files)
+}
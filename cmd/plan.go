@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/gool-cli/gool/internal/plan"
+)
+
+// planNode is a directory or file in the printed plan tree
+type planNode struct {
+	name     string
+	file     *plan.File
+	children map[string]*planNode
+}
+
+// printPlan prints the files a dry run would write as a tree with sizes,
+// followed by a diff for every file that would change
+func printPlan(rootName string, files []plan.File) {
+	cyan := color.New(color.FgCyan, color.Bold)
+	yellow := color.New(color.FgYellow)
+
+	root := &planNode{name: rootName, children: make(map[string]*planNode)}
+	for i := range files {
+		node := root
+		parts := strings.Split(files[i].Path, "/")
+		for _, part := range parts[:len(parts)-1] {
+			child, ok := node.children[part]
+			if !ok {
+				child = &planNode{name: part, children: make(map[string]*planNode)}
+				node.children[part] = child
+			}
+			node = child
+		}
+		node.children[parts[len(parts)-1]] = &planNode{name: parts[len(parts)-1], file: &files[i]}
+	}
+
+	cyan.Printf("%s/\n", root.name)
+	printPlanChildren(root, "")
+	fmt.Println()
+
+	fmt.Printf("%d to create, %d to modify, %d unchanged\n",
+		plan.Count(files, plan.ChangeCreate), plan.Count(files, plan.ChangeModify), plan.Count(files, plan.ChangeUnchanged))
+
+	for _, file := range files {
+		if file.Change != plan.ChangeModify {
+			continue
+		}
+		fmt.Println()
+		printDiff(file.Diff)
+	}
+
+	fmt.Println()
+	yellow.Println("💡 Dry run: nothing was written. Run again without --dry-run to apply.")
+}
+
+func printPlanChildren(node *planNode, prefix string) {
+	names := make([]string, 0, len(node.children))
+	for name := range node.children {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for i, name := range names {
+		child := node.children[name]
+		branch, indent := "├── ", "│   "
+		if i == len(names)-1 {
+			branch, indent = "└── ", "    "
+		}
+
+		if child.file == nil {
+			fmt.Print(prefix + branch)
+			color.New(color.FgCyan).Printf("%s/\n", child.name)
+			printPlanChildren(child, prefix+indent)
+			continue
+		}
+
+		fmt.Print(prefix + branch)
+		size := plan.FormatSize(child.file.Size)
+		switch child.file.Change {
+		case plan.ChangeCreate:
+			color.New(color.FgGreen).Printf("%s (%s)\n", child.name, size)
+		case plan.ChangeModify:
+			color.New(color.FgYellow).Printf("%s (%s, modified)\n", child.name, size)
+		default:
+			color.New(color.FgHiBlack).Printf("%s (%s, unchanged)\n", child.name, size)
+		}
+	}
+}
+
+func printDiff(text string) {
+	for _, line := range strings.SplitAfter(strings.TrimSuffix(text, "\n"), "\n") {
+		line = strings.TrimSuffix(line, "\n")
+		switch {
+		case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+			color.New(color.Bold).Println(line)
+		case strings.HasPrefix(line, "@@"):
+			color.New(color.FgCyan).Println(line)
+		case strings.HasPrefix(line, "+"):
+			color.New(color.FgGreen).Println(line)
+		case strings.HasPrefix(line, "-"):
+			color.New(color.FgRed).Println(line)
+		default:
+			fmt.Println(line)
+		}
+	}
+}
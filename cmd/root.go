@@ -4,11 +4,16 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/fatih/color"
+	"github.com/gool-cli/gool/internal/templates"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
-var cfgFile string
+var (
+	cfgFile      string
+	templatesDir string
+)
 
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
@@ -19,6 +24,9 @@ pre-configured files, and dependencies. It supports various web frameworks,
 databases, architectures, and deployment configurations.
 
 Generate a new Go project with your preferred stack in seconds!`,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		return initTemplates(cmd)
+	},
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
@@ -38,6 +46,7 @@ func init() {
 	// will be global for your application.
 
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.gool.yaml)")
+	rootCmd.PersistentFlags().StringVar(&templatesDir, "templates", "", "directory of template overrides (default is $HOME/.gool/templates)")
 	rootCmd.PersistentFlags().BoolP("verbose", "v", false, "verbose output")
 	rootCmd.PersistentFlags().BoolP("quiet", "q", false, "quiet mode")
 
@@ -69,3 +78,27 @@ func initConfig() {
 		fmt.Fprintln(os.Stderr, "Using config file:", viper.ConfigFileUsed())
 	}
 }
+
+// initTemplates sets the directory templates are read from before the
+// built-in set: --templates, or ~/.gool/templates when it exists
+func initTemplates(cmd *cobra.Command) error {
+	if templatesDir != "" {
+		// eject creates the directory, every other command reads from it
+		if _, err := os.Stat(templatesDir); err != nil && cmd != templatesEjectCmd {
+			color.Red("❌ Template directory %s does not exist", templatesDir)
+			cmd.SilenceUsage = true
+			return fmt.Errorf("template directory %s does not exist", templatesDir)
+		}
+		templates.SetOverrideDir(templatesDir)
+		return nil
+	}
+
+	dir, err := templates.DefaultOverrideDir()
+	if err != nil {
+		return nil
+	}
+	if _, err := os.Stat(dir); err == nil {
+		templates.SetOverrideDir(dir)
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+package cmd
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/gool-cli/gool/internal/generator"
+	"github.com/gool-cli/gool/internal/templates"
+	"github.com/spf13/cobra"
+)
+
+var ejectForce bool
+
+// templatesCmd represents the templates command
+var templatesCmd = &cobra.Command{
+	Use:   "templates",
+	Short: "List and customise the templates gool renders",
+	Long: `List the built-in templates and copy them out for customisation.
+
+gool reads every template from an override directory first and falls back to
+the built-in set. The override directory is set with --templates and defaults
+to ~/.gool/templates. Copy a template there with 'gool templates eject' and
+edit it to change what every generated project gets.`,
+}
+
+// templatesListCmd represents the templates list command
+var templatesListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the built-in templates",
+	Long: `List the built-in templates with the project files they render to. Templates
+replaced by a file in the override directory are marked as overridden.`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE:         runTemplatesList,
+}
+
+// templatesEjectCmd represents the templates eject command
+var templatesEjectCmd = &cobra.Command{
+	Use:   "eject <name>",
+	Short: "Copy a built-in template into the override directory",
+	Long: `Copy a built-in template into the override directory so it can be edited.
+The name is a template name, a directory of templates, or the project file a
+template renders to.
+
+✨ Examples:
+  gool templates eject deploy/Dockerfile.tmpl
+  gool templates eject pkg/logger/logger.go
+  gool templates eject framework/gin --templates ./company-templates`,
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE:         runTemplatesEject,
+}
+
+func init() {
+	rootCmd.AddCommand(templatesCmd)
+	templatesCmd.AddCommand(templatesListCmd)
+	templatesCmd.AddCommand(templatesEjectCmd)
+
+	templatesEjectCmd.Flags().BoolVar(&ejectForce, "force", false, "Overwrite templates that are already in the override directory")
+}
+
+func runTemplatesList(cmd *cobra.Command, args []string) error {
+	cyan := color.New(color.FgCyan, color.Bold)
+	yellow := color.New(color.FgYellow)
+
+	list, err := generator.ListTemplates()
+	if err != nil {
+		color.Red("❌ %v", err)
+		return err
+	}
+
+	fmt.Println()
+	cyan.Println("📦 Built-in templates")
+	if dir := templates.OverrideDir(); dir != "" {
+		fmt.Printf("   Overrides are read from %s\n", dir)
+	}
+	fmt.Println()
+
+	width := 0
+	for _, info := range list {
+		_, name, _ := strings.Cut(info.Name, "/")
+		width = max(width, len(name))
+	}
+
+	group := ""
+	for _, info := range list {
+		top, name, nested := strings.Cut(info.Name, "/")
+		label := "  " + name
+		if !nested {
+			// Top-level files such as the manifest are listed on their own
+			group, label = "", top
+		} else if top != group {
+			group = top
+			cyan.Printf("%s/\n", group)
+		}
+
+		if len(info.Outputs) > 0 {
+			fmt.Printf("%-*s → %s", width+2, label, strings.Join(info.Outputs, ", "))
+		} else {
+			fmt.Print(label)
+		}
+		if isOverridden(info.Name) {
+			yellow.Print("  ✏️  overridden")
+		}
+		fmt.Println()
+	}
+	fmt.Println()
+
+	return nil
+}
+
+func runTemplatesEject(cmd *cobra.Command, args []string) error {
+	green := color.New(color.FgGreen, color.Bold)
+	yellow := color.New(color.FgYellow)
+
+	dir := templates.OverrideDir()
+	if dir == "" {
+		defaultDir, err := templates.DefaultOverrideDir()
+		if err != nil {
+			color.Red("❌ Failed to find the home directory: %v", err)
+			return err
+		}
+		dir = defaultDir
+	}
+
+	names, err := generator.FindTemplates(args[0])
+	if err != nil {
+		color.Red("❌ %v. Run 'gool templates list' to see the templates.", err)
+		return err
+	}
+
+	fmt.Println()
+	builtin := generator.BuiltinTemplates()
+	ejected := 0
+	for _, name := range names {
+		target := filepath.Join(dir, filepath.FromSlash(name))
+		if _, err := os.Stat(target); err == nil && !ejectForce {
+			yellow.Printf("  ⏭️  %s already exists, use --force to overwrite\n", name)
+			continue
+		}
+
+		content, err := fs.ReadFile(builtin, name)
+		if err != nil {
+			color.Red("❌ Failed to read %s: %v", name, err)
+			return err
+		}
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			color.Red("❌ Failed to create %s: %v", path.Dir(name), err)
+			return err
+		}
+		if err := os.WriteFile(target, content, 0644); err != nil {
+			color.Red("❌ Failed to write %s: %v", name, err)
+			return err
+		}
+		color.Green("  ✅ %s", name)
+		ejected++
+	}
+	fmt.Println()
+
+	if ejected == 0 {
+		return nil
+	}
+	green.Printf("🎉 Templates ejected to %s\n", dir)
+	yellow.Println("💡 Edit them there; gool uses them instead of the built-in ones from now on.")
+
+	return nil
+}
+
+// isOverridden reports whether the override directory replaces the template
+func isOverridden(name string) bool {
+	dir := templates.OverrideDir()
+	if dir == "" {
+		return false
+	}
+	_, err := os.Stat(filepath.Join(dir, filepath.FromSlash(name)))
+	return err == nil
+}
@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/gool-cli/gool/internal/upgrade"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+var upgradeDir string
+
+// upgradeCmd represents the upgrade command
+var upgradeCmd = &cobra.Command{
+	Use:   "upgrade",
+	Short: "Upgrade a generated project to the current templates",
+	Long: `Re-render an existing project with the current gool templates and merge
+the result into your working tree.
+
+The project's .gool.yaml manifest provides the original configuration, and the
+pristine copy of the previous render in .gool/base is used as the common
+ancestor of a three-way merge for every file:
+
+  • Files you have not touched are replaced with the new render
+  • Files you edited are merged with the template changes
+  • Overlapping changes are written with conflict markers for you to resolve
+
+✨ Examples:
+  gool upgrade                  # Upgrade the project in the current directory
+  gool upgrade --dir ./my-api   # Upgrade another project`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE:         runUpgrade,
+}
+
+func init() {
+	rootCmd.AddCommand(upgradeCmd)
+
+	upgradeCmd.Flags().StringVar(&upgradeDir, "dir", ".", "Project directory containing .gool.yaml")
+}
+
+func runUpgrade(cmd *cobra.Command, args []string) error {
+	magenta := color.New(color.FgMagenta, color.Bold)
+
+	fmt.Println()
+	magenta.Println("🔄 Upgrading project templates...")
+	fmt.Println()
+
+	report, err := upgrade.Run(afero.NewOsFs(), upgradeDir)
+	if err != nil {
+		color.Red("❌ Upgrade failed: %v", err)
+		return err
+	}
+
+	printUpgradeSummary(report)
+
+	if conflicts := report.Count(upgrade.StatusConflict); conflicts > 0 {
+		return fmt.Errorf("%d file(s) have merge conflicts", conflicts)
+	}
+
+	return nil
+}
+
+func printUpgradeSummary(report *upgrade.Report) {
+	cyan := color.New(color.FgCyan, color.Bold)
+	green := color.New(color.FgGreen, color.Bold)
+	yellow := color.New(color.FgYellow)
+
+	cyan.Printf("📋 Templates v%s → v%s\n", report.FromVersion, report.ToVersion)
+	fmt.Println()
+
+	printFileResults(report)
+
+	if report.Count(upgrade.StatusConflict) > 0 {
+		yellow.Println("💡 Resolve the conflict markers (<<<<<<< yours ... >>>>>>> gool) and review the changes.")
+	} else {
+		green.Println("🎉 Project upgraded successfully!")
+	}
+}
+
+// printFileResults lists what happened to every file touched by an upgrade
+func printFileResults(report *upgrade.Report) {
+	green := color.New(color.FgGreen, color.Bold)
+	yellow := color.New(color.FgYellow)
+	red := color.New(color.FgRed, color.Bold)
+	white := color.New(color.FgWhite)
+
+	for _, file := range report.Files {
+		switch file.Status {
+		case upgrade.StatusUpdated:
+			green.Printf("  ✔ updated   %s\n", file.Path)
+		case upgrade.StatusMerged:
+			green.Printf("  ✔ merged    %s\n", file.Path)
+		case upgrade.StatusPatched:
+			green.Printf("  ✔ patched   %s\n", file.Path)
+		case upgrade.StatusAdded:
+			green.Printf("  + added     %s\n", file.Path)
+		case upgrade.StatusConflict:
+			red.Printf("  ✖ conflict  %s (%d)\n", file.Path, file.Conflicts)
+		case upgrade.StatusSkipped:
+			yellow.Printf("  - skipped   %s (deleted locally)\n", file.Path)
+		case upgrade.StatusRemoved:
+			yellow.Printf("  - removed   %s (no longer generated, left in place)\n", file.Path)
+		}
+	}
+
+	fmt.Println()
+	clean := report.Count(upgrade.StatusUpdated) + report.Count(upgrade.StatusMerged) +
+		report.Count(upgrade.StatusPatched) + report.Count(upgrade.StatusAdded)
+	white.Printf("  %d clean, %d conflicting, %d unchanged\n",
+		clean, report.Count(upgrade.StatusConflict), report.Count(upgrade.StatusUnchanged))
+	fmt.Println()
+}
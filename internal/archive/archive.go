@@ -0,0 +1,132 @@
+// Package archive packs a generated project into a zip or gzipped tar file.
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+// Supported reports whether path has an extension Write can produce
+func Supported(path string) bool {
+	return strings.HasSuffix(path, ".zip") || strings.HasSuffix(path, ".tar.gz") || strings.HasSuffix(path, ".tgz")
+}
+
+// Write packs the files under root in fs into the archive at path, each one
+// under a top-level directory named prefix. The format follows the
+// extension of path: .zip, .tar.gz or .tgz.
+func Write(fs afero.Fs, root, prefix, path string) error {
+	if !Supported(path) {
+		return fmt.Errorf("unsupported archive '%s'. Use a .zip, .tar.gz or .tgz file", path)
+	}
+
+	out, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create archive: %w", err)
+	}
+
+	if strings.HasSuffix(path, ".zip") {
+		err = writeZip(fs, root, prefix, out)
+	} else {
+		err = writeTarGz(fs, root, prefix, out)
+	}
+	if closeErr := out.Close(); err == nil && closeErr != nil {
+		err = fmt.Errorf("failed to write archive: %w", closeErr)
+	}
+	if err != nil {
+		os.Remove(path)
+		return err
+	}
+
+	return nil
+}
+
+// walkFiles calls fn for every file under root with its archive name
+func walkFiles(fs afero.Fs, root, prefix string, fn func(name string, info os.FileInfo, content []byte) error) error {
+	return afero.Walk(fs, root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		content, err := afero.ReadFile(fs, path)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", rel, err)
+		}
+
+		return fn(prefix+"/"+filepath.ToSlash(rel), info, content)
+	})
+}
+
+func writeZip(fs afero.Fs, root, prefix string, w io.Writer) error {
+	zw := zip.NewWriter(w)
+	err := walkFiles(fs, root, prefix, func(name string, info os.FileInfo, content []byte) error {
+		header, err := zip.FileInfoHeader(info)
+		if err != nil {
+			return fmt.Errorf("failed to add %s: %w", name, err)
+		}
+		header.Name = name
+		header.Method = zip.Deflate
+
+		entry, err := zw.CreateHeader(header)
+		if err != nil {
+			return fmt.Errorf("failed to add %s: %w", name, err)
+		}
+		if _, err := entry.Write(content); err != nil {
+			return fmt.Errorf("failed to add %s: %w", name, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("failed to write archive: %w", err)
+	}
+	return nil
+}
+
+func writeTarGz(fs afero.Fs, root, prefix string, w io.Writer) error {
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+	err := walkFiles(fs, root, prefix, func(name string, info os.FileInfo, content []byte) error {
+		header := &tar.Header{
+			Name:    name,
+			Mode:    int64(info.Mode().Perm()),
+			Size:    int64(len(content)),
+			ModTime: info.ModTime(),
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return fmt.Errorf("failed to add %s: %w", name, err)
+		}
+		if _, err := tw.Write(content); err != nil {
+			return fmt.Errorf("failed to add %s: %w", name, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("failed to write archive: %w", err)
+	}
+	if err := gw.Close(); err != nil {
+		return fmt.Errorf("failed to write archive: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,255 @@
+package archlint
+
+import (
+	"fmt"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gool-cli/gool/internal/config"
+	"github.com/gool-cli/gool/internal/generator"
+	"github.com/gool-cli/gool/internal/manifest"
+	"github.com/spf13/afero"
+	"golang.org/x/mod/modfile"
+)
+
+// frameworks are the web framework import paths inner layers must not use
+var frameworks = []string{
+	"github.com/gin-gonic/gin",
+	"github.com/labstack/echo",
+	"github.com/gofiber/fiber",
+	"github.com/revel/revel",
+}
+
+// Layer is a package of the architecture and the dependencies it may have
+type Layer struct {
+	// Name identifies the layer in reports
+	Name string `json:"name"`
+	// Dir is the package directory relative to the project root. Packages
+	// below it belong to the layer too.
+	Dir string `json:"dir"`
+	// Allow lists the layers, by name, the layer may import
+	Allow []string `json:"allow,omitempty"`
+	// NoFrameworks forbids importing a web framework
+	NoFrameworks bool `json:"no_frameworks"`
+}
+
+// Violation is an import that breaks a layering rule
+type Violation struct {
+	File    string `json:"file"`
+	Line    int    `json:"line"`
+	Layer   string `json:"layer"`
+	Import  string `json:"import"`
+	Message string `json:"message"`
+}
+
+// Report is the result of checking a project
+type Report struct {
+	Architecture string      `json:"architecture"`
+	Layers       []Layer     `json:"layers"`
+	Files        int         `json:"files"`
+	Violations   []Violation `json:"violations"`
+}
+
+// Run checks every Go file of the project at projectPath in fs against the
+// layering rules of the architecture recorded in its manifest
+func Run(fs afero.Fs, projectPath string) (*Report, error) {
+	m, err := manifest.Load(fs, projectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	layers, err := LayersFor(&m.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	goMod, err := afero.ReadFile(fs, filepath.Join(projectPath, "go.mod"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read go.mod: %w", err)
+	}
+	modulePath := modfile.ModulePath(goMod)
+	if modulePath == "" {
+		return nil, fmt.Errorf("go.mod has no module path")
+	}
+
+	report := &Report{Architecture: m.Config.Architecture, Layers: layers, Violations: []Violation{}}
+	err = afero.Walk(fs, projectPath, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if filePath != projectPath && skipDir(info.Name()) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		// Tests wire layers together the way internal/app does, so only
+		// production code is checked
+		if !strings.HasSuffix(filePath, ".go") || strings.HasSuffix(filePath, "_test.go") {
+			return nil
+		}
+
+		rel, err := filepath.Rel(projectPath, filePath)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		layer := layerOf(layers, path.Dir(rel))
+		if layer == nil {
+			return nil
+		}
+
+		violations, err := checkFile(fs, filePath, rel, layer, layers, modulePath)
+		if err != nil {
+			return err
+		}
+		report.Files++
+		report.Violations = append(report.Violations, violations...)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to check project: %w", err)
+	}
+
+	sort.SliceStable(report.Violations, func(i, j int) bool {
+		if report.Violations[i].File != report.Violations[j].File {
+			return report.Violations[i].File < report.Violations[j].File
+		}
+		return report.Violations[i].Line < report.Violations[j].Line
+	})
+
+	return report, nil
+}
+
+// LayersFor returns the layers of a project's architecture. Built-in
+// architectures follow the dependencies of the code gool generates for
+// them; custom architectures follow their layout file.
+func LayersFor(cfg *config.ProjectConfig) ([]Layer, error) {
+	if cfg.Architecture == config.ArchCustom {
+		if cfg.Layout == nil {
+			return nil, fmt.Errorf("the custom architecture has no layering rules unless it is generated from a layout file")
+		}
+		return customLayers(cfg.Layout), nil
+	}
+
+	layout := generator.LayoutFor(cfg)
+	model := layout.Model.Dir
+	layers := []Layer{
+		{Name: model, Dir: model, NoFrameworks: true},
+		{Name: layout.Repository.Dir, Dir: layout.Repository.Dir, Allow: []string{model}, NoFrameworks: true},
+	}
+	if layout.HasPorts() {
+		// Hexagonal: the domain and both kinds of adapter meet at the ports
+		ports := layout.Port.Dir
+		layers[1].Allow = append(layers[1].Allow, ports)
+		layers = append(layers,
+			Layer{Name: ports, Dir: ports, Allow: []string{model}, NoFrameworks: true},
+			Layer{Name: layout.Service.Dir, Dir: layout.Service.Dir, Allow: []string{model, ports}, NoFrameworks: true},
+			Layer{Name: layout.Handler.Dir, Dir: layout.Handler.Dir, Allow: []string{model, ports}},
+		)
+		return layers, nil
+	}
+
+	return append(layers,
+		Layer{Name: layout.Service.Dir, Dir: layout.Service.Dir, Allow: []string{model, layout.Repository.Dir}, NoFrameworks: true},
+		Layer{Name: layout.Handler.Dir, Dir: layout.Handler.Dir, Allow: []string{model, layout.Service.Dir}},
+	), nil
+}
+
+// customLayers turns the packages of a layout file into layers
+func customLayers(layout *config.LayoutConfig) []Layer {
+	layers := make([]Layer, 0, len(layout.Packages))
+	for _, pkg := range layout.Packages {
+		layers = append(layers, Layer{
+			Name:  pkg.Name,
+			Dir:   pkg.Path,
+			Allow: pkg.Imports,
+			NoFrameworks: pkg.Role == config.RoleModel || pkg.Role == config.RoleService ||
+				pkg.Role == config.RoleRepository,
+		})
+	}
+	return layers
+}
+
+// checkFile returns the imports of a file that its layer does not allow
+func checkFile(fs afero.Fs, filePath, rel string, layer *Layer, layers []Layer, modulePath string) ([]Violation, error) {
+	src, err := afero.ReadFile(fs, filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", rel, err)
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, rel, src, parser.ImportsOnly)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", rel, err)
+	}
+
+	var violations []Violation
+	for _, spec := range file.Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+		violation := Violation{
+			File:   rel,
+			Line:   fset.Position(spec.Pos()).Line,
+			Layer:  layer.Name,
+			Import: importPath,
+		}
+
+		if layer.NoFrameworks && isFramework(importPath) {
+			violation.Message = fmt.Sprintf("%s must not depend on a web framework", layer.Name)
+			violations = append(violations, violation)
+			continue
+		}
+
+		if importPath != modulePath && !strings.HasPrefix(importPath, modulePath+"/") {
+			continue
+		}
+		target := layerOf(layers, strings.TrimPrefix(strings.TrimPrefix(importPath, modulePath), "/"))
+		if target == nil || target.Name == layer.Name || slices.Contains(layer.Allow, target.Name) {
+			continue
+		}
+		violation.Message = fmt.Sprintf("%s must not import %s", layer.Name, target.Name)
+		violations = append(violations, violation)
+	}
+
+	return violations, nil
+}
+
+// layerOf returns the layer a package directory belongs to, preferring the
+// most specific layer when they nest
+func layerOf(layers []Layer, dir string) *Layer {
+	var found *Layer
+	for i := range layers {
+		layer := &layers[i]
+		if dir != layer.Dir && !strings.HasPrefix(dir, layer.Dir+"/") {
+			continue
+		}
+		if found == nil || len(layer.Dir) > len(found.Dir) {
+			found = layer
+		}
+	}
+	return found
+}
+
+// skipDir reports whether a directory holds no project packages
+func skipDir(name string) bool {
+	return strings.HasPrefix(name, ".") || name == "vendor" || name == "testdata" || name == "node_modules"
+}
+
+func isFramework(importPath string) bool {
+	for _, framework := range frameworks {
+		if importPath == framework || strings.HasPrefix(importPath, framework+"/") {
+			return true
+		}
+	}
+	return false
+}
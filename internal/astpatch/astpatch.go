@@ -0,0 +1,772 @@
+// Package astpatch carries the changes between two renders of a Go file over
+// to a locally edited copy of that file. Changes are located along the syntax
+// tree, so edits elsewhere in the file are left untouched.
+package astpatch
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrConflict is returned when a change touches code that was edited locally
+var ErrConflict = errors.New("change conflicts with a local edit")
+
+// Apply applies the changes that turn base into rendered to current and
+// returns the formatted result. Declarations, struct fields, statements,
+// composite literal elements and imports added by rendered are inserted next
+// to their neighbours; changed nodes are replaced when they were not edited
+// locally and merged recursively when they were.
+func Apply(current, base, rendered []byte) ([]byte, error) {
+	cur, err := parse(current)
+	if err != nil {
+		return nil, err
+	}
+	b, err := parse(base)
+	if err != nil {
+		return nil, err
+	}
+	r, err := parse(rendered)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &patcher{cur: cur}
+	p.mergeImports(b, r)
+	if err := p.mergeList(declList(b), declList(r), declList(cur)); err != nil {
+		return nil, err
+	}
+
+	patched, err := format.Source(p.result())
+	if err != nil {
+		return nil, fmt.Errorf("patched file does not parse: %w", err)
+	}
+	return patched, nil
+}
+
+// AppendToFunc inserts stmts at the end of the body of the top-level
+// function name, ahead of a trailing return, adds the import specs that are
+// missing and returns the formatted result. Statements the body already
+// holds are not appended again.
+func AppendToFunc(src []byte, name, stmts string, imports []string) ([]byte, error) {
+	return AppendToScope(src, name, "", stmts, imports)
+}
+
+// AppendToScope is AppendToFunc for the block of the function that declares
+// the variable scope, so that stmts can use the variables declared alongside
+// it. An empty scope is the function's body.
+func AppendToScope(src []byte, name, scope, stmts string, imports []string) ([]byte, error) {
+	cur, err := parse(src)
+	if err != nil {
+		return nil, err
+	}
+
+	var fn *ast.FuncDecl
+	for _, decl := range cur.file.Decls {
+		if f, ok := decl.(*ast.FuncDecl); ok && f.Recv == nil && f.Name.Name == name && f.Body != nil {
+			fn = f
+		}
+	}
+	if fn == nil {
+		return nil, fmt.Errorf("function %s not found", name)
+	}
+
+	block := fn.Body
+	if scope != "" {
+		if block = declaringBlock(fn.Body, scope); block == nil {
+			return nil, fmt.Errorf("variable %s not declared in %s", scope, name)
+		}
+	}
+
+	p := &patcher{cur: cur}
+
+	existing := make(map[string]bool)
+	for _, spec := range cur.file.Imports {
+		existing[spec.Path.Value] = true
+	}
+	var added []string
+	for _, spec := range imports {
+		fields := strings.Fields(spec)
+		if path := fields[len(fields)-1]; !existing[path] {
+			existing[path] = true
+			added = append(added, spec)
+		}
+	}
+	p.addImports(added)
+
+	if !strings.Contains(normalize(cur.text(block)), normalize(stmts)) {
+		end := block.Rbrace
+		if n := len(block.List); n > 0 {
+			if ret, ok := block.List[n-1].(*ast.ReturnStmt); ok {
+				end = ret.Pos()
+			}
+		}
+		offset := cur.offset(end)
+		if lineStart := strings.LastIndexByte(string(cur.src[:offset]), '\n') + 1; strings.TrimSpace(string(cur.src[lineStart:offset])) == "" {
+			offset = lineStart
+		}
+		p.insert(offset, "\n"+stmts+"\n")
+	}
+
+	patched, err := format.Source(p.result())
+	if err != nil {
+		return nil, fmt.Errorf("patched file does not parse: %w", err)
+	}
+	return patched, nil
+}
+
+// declaringBlock returns the outermost block within body whose statements
+// declare the variable name
+func declaringBlock(body *ast.BlockStmt, name string) *ast.BlockStmt {
+	var found *ast.BlockStmt
+	ast.Inspect(body, func(n ast.Node) bool {
+		block, ok := n.(*ast.BlockStmt)
+		if !ok || found != nil {
+			return found == nil
+		}
+		for _, stmt := range block.List {
+			if declares(stmt, name) {
+				found = block
+				return false
+			}
+		}
+		return true
+	})
+	return found
+}
+
+// declares reports whether stmt declares the variable name
+func declares(stmt ast.Stmt, name string) bool {
+	switch s := stmt.(type) {
+	case *ast.AssignStmt:
+		if s.Tok != token.DEFINE {
+			return false
+		}
+		for _, expr := range s.Lhs {
+			if ident, ok := expr.(*ast.Ident); ok && ident.Name == name {
+				return true
+			}
+		}
+	case *ast.DeclStmt:
+		if gen, ok := s.Decl.(*ast.GenDecl); ok && gen.Tok == token.VAR {
+			for _, spec := range gen.Specs {
+				if strings.Contains(","+identNames(spec.(*ast.ValueSpec).Names)+",", ","+name+",") {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// AppendToLiteral adds elts to the end of the composite literal assigned to
+// the variable name, declared at the top level or in a function, and returns
+// the formatted result. Elements the literal already holds, and keys it
+// already sets, are not added again.
+func AppendToLiteral(src []byte, name string, elts []string) ([]byte, error) {
+	cur, err := parse(src)
+	if err != nil {
+		return nil, err
+	}
+
+	var lit *ast.CompositeLit
+	assigned := func(lhs ast.Expr, value ast.Expr) {
+		if ident, ok := lhs.(*ast.Ident); ok && ident.Name == name && lit == nil {
+			lit, _ = literal(value)
+		}
+	}
+	ast.Inspect(cur.file, func(n ast.Node) bool {
+		switch s := n.(type) {
+		case *ast.ValueSpec:
+			for i := range s.Values {
+				assigned(s.Names[i], s.Values[i])
+			}
+		case *ast.AssignStmt:
+			if len(s.Lhs) == len(s.Rhs) {
+				for i := range s.Rhs {
+					assigned(s.Lhs[i], s.Rhs[i])
+				}
+			}
+		}
+		return lit == nil
+	})
+	if lit == nil {
+		return nil, fmt.Errorf("composite literal %s not found", name)
+	}
+
+	existing := make(map[string]bool)
+	for _, it := range eltList(cur, lit).items {
+		existing[it.key] = true
+	}
+	var added []string
+	for _, elt := range elts {
+		key, err := eltKey(elt)
+		if err != nil {
+			return nil, err
+		}
+		if !existing[key] {
+			existing[key] = true
+			added = append(added, elt)
+		}
+	}
+	if len(added) == 0 {
+		return format.Source(cur.src)
+	}
+
+	p := &patcher{cur: cur}
+	text := strings.Join(added, ",\n") + ","
+	if n := len(lit.Elts); n == 0 {
+		p.insert(cur.offset(lit.Lbrace)+1, "\n"+text+"\n")
+	} else {
+		end := cur.offset(lit.Elts[n-1].End())
+		if rest := string(cur.src[end:]); strings.HasPrefix(strings.TrimLeft(rest, " \t\n"), ",") {
+			p.insert(lineEnd(cur.src, end+strings.Index(rest, ",")+1), "\n"+text)
+		} else {
+			// The last element is followed by the closing brace on its line
+			p.insert(end, ",\n"+strings.TrimSuffix(text, ","))
+		}
+	}
+
+	patched, err := format.Source(p.result())
+	if err != nil {
+		return nil, fmt.Errorf("patched file does not parse: %w", err)
+	}
+	return patched, nil
+}
+
+// eltKey returns the key eltList gives the composite literal element elt
+func eltKey(elt string) (string, error) {
+	src := "T{" + elt + "}"
+	fset := token.NewFileSet()
+	expr, err := parser.ParseExprFrom(fset, "", src, 0)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse element %q: %w", elt, err)
+	}
+	lit := expr.(*ast.CompositeLit)
+	if len(lit.Elts) != 1 {
+		return "", fmt.Errorf("%q is not a single element", elt)
+	}
+	if kv, ok := lit.Elts[0].(*ast.KeyValueExpr); ok {
+		return "key " + normalize(src[fset.Position(kv.Key.Pos()).Offset:fset.Position(kv.Key.End()).Offset]), nil
+	}
+	return normalize(elt), nil
+}
+
+// source is a parsed Go file
+type source struct {
+	fset *token.FileSet
+	file *ast.File
+	src  []byte
+}
+
+func parse(src []byte) (*source, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Go source: %w", err)
+	}
+	return &source{fset: fset, file: file, src: src}, nil
+}
+
+func (s *source) offset(pos token.Pos) int {
+	return s.fset.File(pos).Offset(pos)
+}
+
+func (s *source) text(node ast.Node) string {
+	return string(s.src[s.offset(node.Pos()):s.offset(node.End())])
+}
+
+// normalize collapses whitespace so renders and gofmt'd files compare equal
+func normalize(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// separator is how items of a list are delimited
+type separator int
+
+const (
+	lineSeparated separator = iota
+	commaSeparated
+	declSeparated
+)
+
+// item is a node in an ordered list, identified by a key that is stable
+// across renders
+type item struct {
+	key  string
+	node ast.Node
+	// prev is the end of the previous item, or the opening of the list
+	prev token.Pos
+}
+
+// list is an ordered list of nodes in a source file
+type list struct {
+	src   *source
+	items []item
+	open  token.Pos
+	sep   separator
+}
+
+// edit replaces src[start:end] with text
+type edit struct {
+	start, end int
+	text       string
+}
+
+type patcher struct {
+	cur   *source
+	edits []edit
+}
+
+func (p *patcher) insert(offset int, text string) {
+	p.edits = append(p.edits, edit{start: offset, end: offset, text: text})
+}
+
+func (p *patcher) replace(node ast.Node, text string) {
+	p.edits = append(p.edits, edit{start: p.cur.offset(node.Pos()), end: p.cur.offset(node.End()), text: text})
+}
+
+// result applies every edit to the current source. Insertions at the same
+// offset keep the order in which they were made.
+func (p *patcher) result() []byte {
+	sort.SliceStable(p.edits, func(i, j int) bool {
+		return p.edits[i].start < p.edits[j].start
+	})
+
+	var out []byte
+	last := 0
+	for _, e := range p.edits {
+		out = append(out, p.cur.src[last:e.start]...)
+		out = append(out, e.text...)
+		last = e.end
+	}
+	return append(out, p.cur.src[last:]...)
+}
+
+// mergeList carries additions and changes between the base and rendered
+// lists over to the current list
+func (p *patcher) mergeList(base, rendered, current list) error {
+	baseItems := index(base.items)
+	currentItems := index(current.items)
+
+	for i, r := range rendered.items {
+		if b, ok := baseItems[r.key]; ok {
+			c, ok := currentItems[r.key]
+			if !ok || normalize(base.src.text(b.node)) == normalize(rendered.src.text(r.node)) {
+				// Unchanged by the render, or removed locally
+				continue
+			}
+			if err := p.mergeNode(base.src, rendered.src, b.node, r.node, c.node); err != nil {
+				return err
+			}
+			continue
+		}
+		if _, ok := currentItems[r.key]; ok {
+			continue
+		}
+
+		// Insert after the closest preceding item that exists locally
+		var anchor *item
+		for j := i - 1; j >= 0 && anchor == nil; j-- {
+			if c, ok := currentItems[rendered.items[j].key]; ok {
+				anchor = &c
+			}
+		}
+		p.insertItem(rendered, r, current, anchor)
+	}
+
+	return nil
+}
+
+func index(items []item) map[string]item {
+	m := make(map[string]item, len(items))
+	for _, it := range items {
+		m[it.key] = it
+	}
+	return m
+}
+
+// insertItem inserts a rendered item after anchor, or at the start of the
+// current list when there is no anchor
+func (p *patcher) insertItem(rendered list, r item, current list, anchor *item) {
+	text, blank := itemText(rendered.src, r, rendered.sep)
+	src := p.cur.src
+
+	if rendered.sep == commaSeparated {
+		if anchor == nil {
+			p.insert(p.cur.offset(current.open), "\n"+text+",")
+			return
+		}
+		end := p.cur.offset(anchor.node.End())
+		rest := strings.TrimLeft(string(src[end:]), " \t\n")
+		if strings.HasPrefix(rest, ",") {
+			p.insert(end+strings.Index(string(src[end:]), ",")+1, "\n"+text+",")
+		} else {
+			// The anchor is the last element and the brace follows on its line
+			p.insert(end, ",\n"+text)
+		}
+		return
+	}
+
+	prefix := "\n"
+	if blank || rendered.sep == declSeparated {
+		prefix = "\n\n"
+	}
+	if anchor == nil {
+		p.insert(p.cur.offset(current.open), prefix+text)
+		return
+	}
+	p.insert(lineEnd(src, p.cur.offset(anchor.node.End())), prefix+text)
+}
+
+// lineEnd returns the end of the line containing offset when the rest of
+// the line holds only a comment, so inserted code stays below it
+func lineEnd(src []byte, offset int) int {
+	rest := string(src[offset:])
+	if i := strings.IndexByte(rest, '\n'); i >= 0 {
+		rest = rest[:i]
+	}
+	trimmed := strings.TrimSpace(rest)
+	if trimmed == "" || strings.HasPrefix(trimmed, "//") {
+		return offset + len(rest)
+	}
+	return offset
+}
+
+// itemText returns the source of a rendered item with its leading and
+// trailing comments, and whether a blank line preceded it
+func itemText(src *source, it item, sep separator) (string, bool) {
+	start := src.offset(it.node.Pos())
+	text := src.text(it.node)
+
+	lead := ""
+	blank := false
+	gap := string(src.src[src.offset(it.prev):start])
+	if i := strings.IndexByte(gap, '\n'); i >= 0 {
+		rest := gap[i+1:]
+		blank = strings.HasPrefix(strings.TrimLeft(rest, " \t"), "\n")
+		lead = strings.TrimSpace(rest)
+	}
+	if lead != "" {
+		text = lead + "\n" + text
+	}
+
+	if sep != commaSeparated {
+		end := src.offset(it.node.End())
+		rest := string(src.src[end:])
+		if i := strings.IndexByte(rest, '\n'); i >= 0 {
+			rest = rest[:i]
+		}
+		if trimmed := strings.TrimSpace(rest); strings.HasPrefix(trimmed, "//") {
+			text += " " + trimmed
+		}
+	}
+
+	return text, blank
+}
+
+// mergeNode carries the change from b to r over to c, which all share a key
+func (p *patcher) mergeNode(bsrc, rsrc *source, b, r, c ast.Node) error {
+	if normalize(p.cur.text(c)) == normalize(bsrc.text(b)) {
+		p.replace(c, rsrc.text(r))
+		return nil
+	}
+
+	switch rn := r.(type) {
+	case *ast.FuncDecl:
+		bn, cn := b.(*ast.FuncDecl), c.(*ast.FuncDecl)
+		if bn.Body == nil || rn.Body == nil || cn.Body == nil ||
+			normalize(bsrc.text(bn.Type)) != normalize(rsrc.text(rn.Type)) {
+			return conflict(rsrc, r)
+		}
+		return p.mergeList(blockList(bsrc, bn.Body), blockList(rsrc, rn.Body), blockList(p.cur, cn.Body))
+
+	case *ast.GenDecl:
+		bn, cn := b.(*ast.GenDecl), c.(*ast.GenDecl)
+		if rn.Lparen.IsValid() && bn.Lparen.IsValid() && cn.Lparen.IsValid() {
+			return p.mergeList(specList(bsrc, bn), specList(rsrc, rn), specList(p.cur, cn))
+		}
+		if len(rn.Specs) == 1 && len(bn.Specs) == 1 && len(cn.Specs) == 1 {
+			return p.mergeNode(bsrc, rsrc, bn.Specs[0], rn.Specs[0], cn.Specs[0])
+		}
+
+	case *ast.TypeSpec:
+		bt, bok := b.(*ast.TypeSpec).Type.(*ast.StructType)
+		rt, rok := rn.Type.(*ast.StructType)
+		ct, cok := c.(*ast.TypeSpec).Type.(*ast.StructType)
+		if bok && rok && cok {
+			return p.mergeList(fieldList(bsrc, bt), fieldList(rsrc, rt), fieldList(p.cur, ct))
+		}
+
+	case *ast.ValueSpec:
+		bn, cn := b.(*ast.ValueSpec), c.(*ast.ValueSpec)
+		return p.mergeExprs(bsrc, rsrc, bn.Values, rn.Values, cn.Values, r)
+
+	case *ast.AssignStmt:
+		bn, cn := b.(*ast.AssignStmt), c.(*ast.AssignStmt)
+		return p.mergeExprs(bsrc, rsrc, bn.Rhs, rn.Rhs, cn.Rhs, r)
+
+	case *ast.ReturnStmt:
+		bn, cn := b.(*ast.ReturnStmt), c.(*ast.ReturnStmt)
+		return p.mergeExprs(bsrc, rsrc, bn.Results, rn.Results, cn.Results, r)
+
+	case *ast.DeclStmt:
+		return p.mergeNode(bsrc, rsrc, b.(*ast.DeclStmt).Decl, rn.Decl, c.(*ast.DeclStmt).Decl)
+
+	case *ast.KeyValueExpr:
+		return p.mergeExpr(bsrc, rsrc, b.(*ast.KeyValueExpr).Value, rn.Value, c.(*ast.KeyValueExpr).Value)
+	}
+
+	return conflict(rsrc, r)
+}
+
+func (p *patcher) mergeExprs(bsrc, rsrc *source, b, r, c []ast.Expr, parent ast.Node) error {
+	if len(b) != len(r) || len(b) != len(c) {
+		return conflict(rsrc, parent)
+	}
+	for i := range r {
+		if err := p.mergeExpr(bsrc, rsrc, b[i], r[i], c[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mergeExpr merges expressions, descending into composite literals
+func (p *patcher) mergeExpr(bsrc, rsrc *source, b, r, c ast.Expr) error {
+	if normalize(bsrc.text(b)) == normalize(rsrc.text(r)) {
+		return nil
+	}
+	if normalize(p.cur.text(c)) == normalize(bsrc.text(b)) {
+		p.replace(c, rsrc.text(r))
+		return nil
+	}
+
+	bl, bok := literal(b)
+	rl, rok := literal(r)
+	cl, cok := literal(c)
+	if !bok || !rok || !cok {
+		return conflict(rsrc, r)
+	}
+	return p.mergeList(eltList(bsrc, bl), eltList(rsrc, rl), eltList(p.cur, cl))
+}
+
+// literal unwraps &T{...} to its composite literal
+func literal(expr ast.Expr) (*ast.CompositeLit, bool) {
+	if unary, ok := expr.(*ast.UnaryExpr); ok && unary.Op == token.AND {
+		expr = unary.X
+	}
+	lit, ok := expr.(*ast.CompositeLit)
+	return lit, ok
+}
+
+func conflict(src *source, node ast.Node) error {
+	return fmt.Errorf("%w at line %d", ErrConflict, src.fset.Position(node.Pos()).Line)
+}
+
+// mergeImports adds the imports rendered gained over base to current
+func (p *patcher) mergeImports(base, rendered *source) {
+	existing := make(map[string]bool)
+	for _, spec := range base.file.Imports {
+		existing[spec.Path.Value] = true
+	}
+	for _, spec := range p.cur.file.Imports {
+		existing[spec.Path.Value] = true
+	}
+
+	var added []string
+	for _, spec := range rendered.file.Imports {
+		if existing[spec.Path.Value] {
+			continue
+		}
+		existing[spec.Path.Value] = true
+		added = append(added, rendered.text(spec))
+	}
+	p.addImports(added)
+}
+
+// addImports inserts import specs into current
+func (p *patcher) addImports(added []string) {
+	if len(added) == 0 {
+		return
+	}
+
+	var last *ast.GenDecl
+	for _, decl := range p.cur.file.Decls {
+		if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.IMPORT {
+			last = gen
+		}
+	}
+
+	switch {
+	case last == nil:
+		p.insert(p.cur.offset(p.cur.file.Name.End()), "\n\nimport (\n"+strings.Join(added, "\n")+"\n)")
+	case last.Lparen.IsValid():
+		// Add to the last import group so gofmt sorts the new imports into it
+		rparen := p.cur.offset(last.Rparen)
+		lineStart := strings.LastIndexByte(string(p.cur.src[:rparen]), '\n') + 1
+		if strings.TrimSpace(string(p.cur.src[lineStart:rparen])) == "" {
+			p.insert(lineStart, strings.Join(added, "\n")+"\n")
+		} else {
+			p.insert(rparen, "\n"+strings.Join(added, "\n")+"\n")
+		}
+	default:
+		p.insert(p.cur.offset(last.End()), "\nimport "+strings.Join(added, "\nimport "))
+	}
+}
+
+// declList returns the top-level declarations other than imports
+func declList(src *source) list {
+	l := list{src: src, open: src.file.Name.End(), sep: declSeparated}
+	for _, decl := range src.file.Decls {
+		if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.IMPORT {
+			l.open = gen.End()
+		}
+	}
+
+	prev := l.open
+	keys := newKeyer()
+	for _, decl := range src.file.Decls {
+		if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.IMPORT {
+			continue
+		}
+		l.items = append(l.items, item{key: keys.key(declKey(src, decl)), node: decl, prev: prev})
+		prev = decl.End()
+	}
+	return l
+}
+
+func declKey(src *source, decl ast.Decl) string {
+	switch d := decl.(type) {
+	case *ast.FuncDecl:
+		key := "func "
+		if d.Recv != nil && len(d.Recv.List) > 0 {
+			key += "(" + normalize(src.text(d.Recv.List[0].Type)) + ")."
+		}
+		return key + d.Name.Name
+	case *ast.GenDecl:
+		names := make([]string, 0, len(d.Specs))
+		for _, spec := range d.Specs {
+			names = append(names, specKey(src, spec))
+		}
+		return d.Tok.String() + " " + strings.Join(names, ",")
+	}
+	return normalize(src.text(decl))
+}
+
+func specKey(src *source, spec ast.Spec) string {
+	switch s := spec.(type) {
+	case *ast.TypeSpec:
+		return s.Name.Name
+	case *ast.ValueSpec:
+		return identNames(s.Names)
+	case *ast.ImportSpec:
+		return s.Path.Value
+	}
+	return normalize(src.text(spec))
+}
+
+func specList(src *source, decl *ast.GenDecl) list {
+	l := list{src: src, open: decl.Lparen + 1, sep: lineSeparated}
+	prev := l.open
+	keys := newKeyer()
+	for _, spec := range decl.Specs {
+		l.items = append(l.items, item{key: keys.key(specKey(src, spec)), node: spec, prev: prev})
+		prev = spec.End()
+	}
+	return l
+}
+
+func fieldList(src *source, st *ast.StructType) list {
+	l := list{src: src, open: st.Fields.Opening + 1, sep: lineSeparated}
+	prev := l.open
+	keys := newKeyer()
+	for _, field := range st.Fields.List {
+		key := identNames(field.Names)
+		if key == "" {
+			key = normalize(src.text(field.Type))
+		}
+		l.items = append(l.items, item{key: keys.key(key), node: field, prev: prev})
+		prev = field.End()
+	}
+	return l
+}
+
+func blockList(src *source, block *ast.BlockStmt) list {
+	l := list{src: src, open: block.Lbrace + 1, sep: lineSeparated}
+	prev := l.open
+	keys := newKeyer()
+	for _, stmt := range block.List {
+		l.items = append(l.items, item{key: keys.key(stmtKey(src, stmt)), node: stmt, prev: prev})
+		prev = stmt.End()
+	}
+	return l
+}
+
+// stmtKey identifies statements by what they define or test rather than by
+// their full text, so edited statements still line up
+func stmtKey(src *source, stmt ast.Stmt) string {
+	switch s := stmt.(type) {
+	case *ast.AssignStmt:
+		lhs := make([]string, 0, len(s.Lhs))
+		for _, expr := range s.Lhs {
+			lhs = append(lhs, normalize(src.text(expr)))
+		}
+		return "assign " + strings.Join(lhs, ",") + " " + s.Tok.String()
+	case *ast.DeclStmt:
+		return "decl " + declKey(src, s.Decl)
+	case *ast.IfStmt:
+		key := "if "
+		if s.Init != nil {
+			key += normalize(src.text(s.Init)) + "; "
+		}
+		return key + normalize(src.text(s.Cond))
+	case *ast.ReturnStmt:
+		return "return"
+	}
+	return normalize(src.text(stmt))
+}
+
+func eltList(src *source, lit *ast.CompositeLit) list {
+	l := list{src: src, open: lit.Lbrace + 1, sep: commaSeparated}
+	prev := l.open
+	keys := newKeyer()
+	for _, elt := range lit.Elts {
+		key := normalize(src.text(elt))
+		if kv, ok := elt.(*ast.KeyValueExpr); ok {
+			key = "key " + normalize(src.text(kv.Key))
+		}
+		l.items = append(l.items, item{key: keys.key(key), node: elt, prev: prev})
+		prev = elt.End()
+	}
+	return l
+}
+
+func identNames(idents []*ast.Ident) string {
+	names := make([]string, 0, len(idents))
+	for _, ident := range idents {
+		names = append(names, ident.Name)
+	}
+	return strings.Join(names, ",")
+}
+
+// keyer numbers repeated keys so every item in a list is unique
+type keyer map[string]int
+
+func newKeyer() keyer {
+	return make(keyer)
+}
+
+func (k keyer) key(key string) string {
+	k[key]++
+	if k[key] == 1 {
+		return key
+	}
+	return key + "#" + strconv.Itoa(k[key])
+}
@@ -0,0 +1,465 @@
+package astpatch
+
+import (
+	"errors"
+	"testing"
+)
+
+const routes = `package routes
+
+import (
+	"github.com/acme/app/internal/handlers"
+)
+
+func SetupRoutes(api *Group) {
+	// User routes
+	userHandler := handlers.NewUserHandler()
+	api.GET("/users", userHandler.List)
+}
+`
+
+const productRoutes = `// Product routes
+productHandler := handlers.NewProductHandler(services.NewProductService())
+api.GET("/products", productHandler.List)`
+
+func TestAppendToFunc(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     string
+		imports []string
+		want    string
+	}{
+		{
+			name:    "appends statements and imports",
+			src:     routes,
+			imports: []string{`"github.com/acme/app/internal/handlers"`, `"github.com/acme/app/internal/services"`},
+			want: `package routes
+
+import (
+	"github.com/acme/app/internal/handlers"
+	"github.com/acme/app/internal/services"
+)
+
+func SetupRoutes(api *Group) {
+	// User routes
+	userHandler := handlers.NewUserHandler()
+	api.GET("/users", userHandler.List)
+
+	// Product routes
+	productHandler := handlers.NewProductHandler(services.NewProductService())
+	api.GET("/products", productHandler.List)
+}
+`,
+		},
+		{
+			name: "inserts ahead of a trailing return",
+			src: `package routes
+
+func SetupRoutes(api *Group) error {
+	api.Use(logger)
+	return nil
+}
+`,
+			want: `package routes
+
+func SetupRoutes(api *Group) error {
+	api.Use(logger)
+
+	// Product routes
+	productHandler := handlers.NewProductHandler(services.NewProductService())
+	api.GET("/products", productHandler.List)
+	return nil
+}
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			once, err := AppendToFunc([]byte(tt.src), "SetupRoutes", productRoutes, tt.imports)
+			if err != nil {
+				t.Fatalf("AppendToFunc failed: %v", err)
+			}
+			if string(once) != tt.want {
+				t.Errorf("expected\n%s\ngot\n%s", tt.want, once)
+			}
+
+			// Appending the same statements and imports again changes nothing
+			twice, err := AppendToFunc(once, "SetupRoutes", productRoutes, tt.imports)
+			if err != nil {
+				t.Fatalf("second AppendToFunc failed: %v", err)
+			}
+			if string(twice) != string(once) {
+				t.Errorf("expected appending twice to be a no-op, got\n%s", twice)
+			}
+		})
+	}
+}
+
+func TestAppendToFuncMissingFunction(t *testing.T) {
+	if _, err := AppendToFunc([]byte(routes), "RegisterRoutes", productRoutes, nil); err == nil {
+		t.Error("expected an error for a missing function")
+	}
+}
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		name     string
+		base     string
+		rendered string
+		current  string
+		want     string
+	}{
+		{
+			name: "adds a declaration next to local edits",
+			base: `package config
+
+type Config struct {
+	Port string
+}
+`,
+			rendered: `package config
+
+type Config struct {
+	Port string
+}
+
+func Default() *Config {
+	return &Config{Port: "8080"}
+}
+`,
+			current: `package config
+
+// Config is edited locally
+type Config struct {
+	Port string
+	Name string
+}
+`,
+			want: `package config
+
+// Config is edited locally
+type Config struct {
+	Port string
+	Name string
+}
+
+func Default() *Config {
+	return &Config{Port: "8080"}
+}
+`,
+		},
+		{
+			name: "adds a field, an element and an import",
+			base: `package app
+
+import (
+	"fmt"
+)
+
+type Config struct {
+	Port string
+}
+
+var features = []string{
+	"health",
+}
+
+func Run() {
+	fmt.Println("run")
+}
+`,
+			rendered: `package app
+
+import (
+	"fmt"
+	"log"
+)
+
+type Config struct {
+	Port    string
+	Metrics bool
+}
+
+var features = []string{
+	"health",
+	"metrics",
+}
+
+func Run() {
+	fmt.Println("run")
+	log.Println("metrics")
+}
+`,
+			current: `package app
+
+import (
+	"fmt"
+)
+
+type Config struct {
+	Port string
+	Name string
+}
+
+var features = []string{
+	"health",
+	"swagger",
+}
+
+func Run() {
+	fmt.Println("run")
+	fmt.Println("mine")
+}
+`,
+			want: `package app
+
+import (
+	"fmt"
+	"log"
+)
+
+type Config struct {
+	Port    string
+	Metrics bool
+	Name    string
+}
+
+var features = []string{
+	"health",
+	"metrics",
+	"swagger",
+}
+
+func Run() {
+	fmt.Println("run")
+	log.Println("metrics")
+	fmt.Println("mine")
+}
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			once, err := Apply([]byte(tt.current), []byte(tt.base), []byte(tt.rendered))
+			if err != nil {
+				t.Fatalf("Apply failed: %v", err)
+			}
+			if string(once) != tt.want {
+				t.Errorf("expected\n%s\ngot\n%s", tt.want, once)
+			}
+
+			// Re-applying the patch to its own result changes nothing
+			twice, err := Apply(once, []byte(tt.base), []byte(tt.rendered))
+			if err != nil {
+				t.Fatalf("second Apply failed: %v", err)
+			}
+			if string(twice) != string(once) {
+				t.Errorf("expected re-applying to be a no-op, got\n%s", twice)
+			}
+		})
+	}
+}
+
+func TestApplyConflict(t *testing.T) {
+	base := `package app
+
+func Port() string {
+	return "8080"
+}
+`
+	rendered := `package app
+
+func Port() string {
+	return "3000"
+}
+`
+	current := `package app
+
+func Port() string {
+	return "9090"
+}
+`
+	if _, err := Apply([]byte(current), []byte(base), []byte(rendered)); !errors.Is(err, ErrConflict) {
+		t.Errorf("expected ErrConflict, got %v", err)
+	}
+}
+
+func TestAppendToScope(t *testing.T) {
+	src := `package routes
+
+func SetupRoutes(router *Router) {
+	api := router.Group("/api/v1")
+	{
+		authorize := Authorize(Access)
+		api.GET("/users", authorize, userHandler.List)
+	}
+}
+`
+	stmts := `api.GET("/products", authorize, productHandler.List)`
+	want := `package routes
+
+func SetupRoutes(router *Router) {
+	api := router.Group("/api/v1")
+	{
+		authorize := Authorize(Access)
+		api.GET("/users", authorize, userHandler.List)
+
+		api.GET("/products", authorize, productHandler.List)
+	}
+}
+`
+
+	tests := []struct {
+		name    string
+		scope   string
+		want    string
+		wantErr bool
+	}{
+		{name: "appends to the block declaring the scope", scope: "authorize", want: want},
+		{name: "appends to the body for an empty scope", scope: "", want: `package routes
+
+func SetupRoutes(router *Router) {
+	api := router.Group("/api/v1")
+	{
+		authorize := Authorize(Access)
+		api.GET("/users", authorize, userHandler.List)
+	}
+
+	api.GET("/products", authorize, productHandler.List)
+}
+`},
+		{name: "fails for an undeclared scope", scope: "keyService", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AppendToScope([]byte(src), "SetupRoutes", tt.scope, stmts, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Error("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("AppendToScope failed: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("expected\n%s\ngot\n%s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestAppendToLiteral(t *testing.T) {
+	tests := []struct {
+		name     string
+		src      string
+		variable string
+		elts     []string
+		want     string
+	}{
+		{
+			name: "adds keys to a top-level map",
+			src: `package routes
+
+var Access = rbac.Access{
+	"GET /api/v1/users": "users:read",
+}
+`,
+			variable: "Access",
+			elts:     []string{`"GET /api/v1/users": "users:write"`, `"GET /api/v1/products": "products:read"`},
+			want: `package routes
+
+var Access = rbac.Access{
+	"GET /api/v1/users":    "users:read",
+	"GET /api/v1/products": "products:read",
+}
+`,
+		},
+		{
+			name: "adds elements to a literal in a function",
+			src: `package routes
+
+func TestAccess(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		roles    []string
+	}{
+		{"GET /api/v1/users", []string{"user", "admin"}}, // readable by users
+	}
+	_ = tests
+}
+`,
+			variable: "tests",
+			elts:     []string{`{"GET /api/v1/users", []string{"user", "admin"}}`, `{"GET /api/v1/products", []string{"admin"}}`},
+			want: `package routes
+
+func TestAccess(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		roles    []string
+	}{
+		{"GET /api/v1/users", []string{"user", "admin"}}, // readable by users
+		{"GET /api/v1/products", []string{"admin"}},
+	}
+	_ = tests
+}
+`,
+		},
+		{
+			name: "adds elements after a last element closed on its line",
+			src: `package routes
+
+var names = []string{"users"}
+`,
+			variable: "names",
+			elts:     []string{`"products"`},
+			want: `package routes
+
+var names = []string{"users",
+	"products"}
+`,
+		},
+		{
+			name: "adds elements to an empty literal",
+			src: `package routes
+
+var names = []string{}
+`,
+			variable: "names",
+			elts:     []string{`"products"`},
+			want: `package routes
+
+var names = []string{
+	"products",
+}
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			once, err := AppendToLiteral([]byte(tt.src), tt.variable, tt.elts)
+			if err != nil {
+				t.Fatalf("AppendToLiteral failed: %v", err)
+			}
+			if string(once) != tt.want {
+				t.Errorf("expected\n%s\ngot\n%s", tt.want, once)
+			}
+
+			// Adding the same elements again changes nothing
+			twice, err := AppendToLiteral(once, tt.variable, tt.elts)
+			if err != nil {
+				t.Fatalf("second AppendToLiteral failed: %v", err)
+			}
+			if string(twice) != string(once) {
+				t.Errorf("expected adding twice to be a no-op, got\n%s", twice)
+			}
+		})
+	}
+}
+
+func TestAppendToLiteralMissingVariable(t *testing.T) {
+	src := []byte("package routes\n\nvar Access = rbac.Access{}\n")
+	if _, err := AppendToLiteral(src, "Routes", []string{`"GET /": "read"`}); err == nil {
+		t.Error("expected an error for a missing variable")
+	}
+}
@@ -0,0 +1,203 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"go/token"
+	"os"
+	"path"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// RoleNames lists every role a layout package can play
+var RoleNames = []string{
+	RoleHandler,
+	RoleService,
+	RoleRepository,
+	RoleModel,
+	RoleRoute,
+}
+
+// roleImports are the imports generated code makes between roles, which a
+// layout must allow
+var roleImports = map[string][]string{
+	RoleHandler:    {RoleService, RoleModel},
+	RoleService:    {RoleRepository, RoleModel},
+	RoleRepository: {RoleModel},
+	RoleRoute:      {RoleHandler, RoleService, RoleRepository},
+}
+
+// reservedPaths hold packages gool generates itself, so generated code
+// cannot be placed in them by role
+var reservedPaths = []string{"internal/app", "internal/middleware", "pkg"}
+
+// importedNames are the names generated code imports other packages under,
+// which a role's package is aliased away from
+var importedNames = []string{
+	// Standard library
+	"base64", "big", "bytes", "context", "cookiejar", "crypto", "embed", "errors", "filepath",
+	"fmt", "gob", "hex", "hmac", "http", "httptest", "io", "json", "log", "net", "os", "pem",
+	"rand", "rsa", "sha256", "slices", "sort", "sql", "strconv", "strings", "subtle", "sync",
+	"template", "testing", "time", "url", "x509",
+	// Frameworks, drivers and libraries
+	"adaptor", "bcrypt", "bson", "bun", "casbin", "cors", "dialect", "echo", "echoSwagger", "ent",
+	"entsql", "fiber", "fiberSwagger", "fiberws", "field", "gin", "ginSwagger", "github",
+	"godotenv", "goi18n", "goose", "gorm", "gws", "index", "jwt", "language", "lipgloss",
+	"logrus", "mongo", "mysql", "mysqldialect", "oauth2", "oidc", "options", "pgdialect",
+	"pgdriver", "postgres", "prometheus", "promhttp", "readpref", "recover", "redis", "revel",
+	"schema", "sqlite", "sqlitedialect", "sqlx", "swaggerFiles", "viper", "yaml", "zap", "zapcore",
+	// Packages gool generates
+	"app", "cache", "config", "database", "docs", "enttest", "i18n", "logger", "metrics",
+	"middleware", "migrations", "oauth", "oauthtest", "pkgLogger", "rbac", "sqlc", "startup",
+	"store", "testutils", "token", "views", "websocket",
+}
+
+// LoadLayout reads and validates a layout file
+func LoadLayout(file string) (*LayoutConfig, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read layout %s: %w", file, err)
+	}
+
+	layout := &LayoutConfig{}
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(layout); err != nil {
+		return nil, fmt.Errorf("failed to parse layout %s: %w", file, err)
+	}
+	if err := layout.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid layout %s: %w", file, err)
+	}
+
+	return layout, nil
+}
+
+// Validate checks that the layout declares a package for every role and
+// allows the imports generated code makes between them
+func (l *LayoutConfig) Validate() error {
+	if len(l.Packages) == 0 {
+		return fmt.Errorf("no packages declared")
+	}
+
+	names := make(map[string]bool)
+	paths := make(map[string]bool)
+	roles := make(map[string]bool)
+	for _, pkg := range l.Packages {
+		if pkg.Name == "" {
+			return fmt.Errorf("package %s has no name", pkg.Path)
+		}
+		if names[pkg.Name] {
+			return fmt.Errorf("package %s is declared twice", pkg.Name)
+		}
+		names[pkg.Name] = true
+
+		if pkg.Path == "" || path.IsAbs(pkg.Path) || path.Clean(pkg.Path) != pkg.Path || strings.HasPrefix(pkg.Path, "..") {
+			return fmt.Errorf("package %s needs a clean path relative to the project root, got '%s'", pkg.Name, pkg.Path)
+		}
+		if paths[pkg.Path] {
+			return fmt.Errorf("path %s is used by more than one package", pkg.Path)
+		}
+		paths[pkg.Path] = true
+		if base := path.Base(pkg.Path); !token.IsIdentifier(base) {
+			return fmt.Errorf("package %s: '%s' is not a valid Go package name", pkg.Name, base)
+		}
+		if pkg.Alias != "" && !token.IsIdentifier(pkg.Alias) {
+			return fmt.Errorf("package %s: alias '%s' is not a valid Go identifier", pkg.Name, pkg.Alias)
+		}
+		if slices.Contains(importedNames, pkg.Alias) {
+			return fmt.Errorf("package %s: alias '%s' collides with a package generated code imports", pkg.Name, pkg.Alias)
+		}
+
+		if pkg.Role != "" {
+			if !slices.Contains(RoleNames, pkg.Role) {
+				return fmt.Errorf("package %s has unknown role '%s'. Valid roles: %s", pkg.Name, pkg.Role, strings.Join(RoleNames, ", "))
+			}
+			for _, reserved := range reservedPaths {
+				if pkg.Path == reserved || strings.HasPrefix(pkg.Path, reserved+"/") {
+					return fmt.Errorf("package %s: the %s role cannot be placed in %s, which gool generates itself", pkg.Name, pkg.Role, pkg.Path)
+				}
+			}
+			if roles[pkg.Role] {
+				return fmt.Errorf("more than one package has the %s role", pkg.Role)
+			}
+			roles[pkg.Role] = true
+		}
+	}
+
+	for _, role := range RoleNames {
+		if !roles[role] {
+			return fmt.Errorf("no package has the %s role", role)
+		}
+	}
+
+	imported := make(map[string]string)
+	for _, role := range RoleNames {
+		name := l.ImportName(role)
+		if other, ok := imported[name]; ok {
+			return fmt.Errorf("the %s and %s packages are both imported as %s. Set an alias on one of them", other, l.Package(role).Name, name)
+		}
+		imported[name] = l.Package(role).Name
+	}
+
+	for _, pkg := range l.Packages {
+		for _, name := range pkg.Imports {
+			if !names[name] {
+				return fmt.Errorf("package %s imports unknown package %s", pkg.Name, name)
+			}
+			if name == pkg.Name {
+				return fmt.Errorf("package %s imports itself", pkg.Name)
+			}
+		}
+	}
+
+	for _, role := range RoleNames {
+		from := l.Package(role)
+		for _, target := range roleImports[role] {
+			to := l.Package(target)
+			if !l.Allows(from.Name, to.Name) {
+				return fmt.Errorf("package %s (%s) must be allowed to import %s (%s), which generated code uses", from.Name, role, to.Name, target)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Package returns the package with the given role, or nil if none has it
+func (l *LayoutConfig) Package(role string) *PackageConfig {
+	for i := range l.Packages {
+		if l.Packages[i].Role == role {
+			return &l.Packages[i]
+		}
+	}
+	return nil
+}
+
+// ImportName returns the name generated code imports the package with the
+// given role under: its alias, or else its base name, suffixed with the role
+// when another package generated code imports has that name
+func (l *LayoutConfig) ImportName(role string) string {
+	pkg := l.Package(role)
+	if pkg.Alias != "" {
+		return pkg.Alias
+	}
+	base := path.Base(pkg.Path)
+	if slices.Contains(importedNames, base) {
+		return base + role
+	}
+	return base
+}
+
+// Allows reports whether the package named from may import the package
+// named to
+func (l *LayoutConfig) Allows(from, to string) bool {
+	for _, pkg := range l.Packages {
+		if pkg.Name == from {
+			return slices.Contains(pkg.Imports, to)
+		}
+	}
+	return false
+}
@@ -0,0 +1,135 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+// validLayout returns a layout giving every role its own package, with the
+// imports generated code needs
+func validLayout() *LayoutConfig {
+	return &LayoutConfig{Packages: []PackageConfig{
+		{Name: "transport", Path: "internal/transport/rest", Role: RoleHandler, Imports: []string{"core", "domain"}},
+		{Name: "core", Path: "internal/core", Role: RoleService, Imports: []string{"store", "domain"}},
+		{Name: "store", Path: "internal/storage", Role: RoleRepository, Imports: []string{"domain"}},
+		{Name: "domain", Path: "internal/domain", Role: RoleModel},
+		{Name: "router", Path: "internal/router", Role: RoleRoute, Imports: []string{"transport", "core", "store"}},
+	}}
+}
+
+func TestLayoutValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		edit    func(l *LayoutConfig)
+		wantErr string
+	}{
+		{
+			name: "accepts a complete layout",
+			edit: func(l *LayoutConfig) {},
+		},
+		{
+			name: "accepts a handler package named after net/http",
+			edit: func(l *LayoutConfig) { l.Packages[0].Path = "internal/transport/http" },
+		},
+		{
+			name: "accepts a repository package named after pkg/database",
+			edit: func(l *LayoutConfig) { l.Packages[2].Path = "internal/db/database" },
+		},
+		{
+			name:    "rejects an empty layout",
+			edit:    func(l *LayoutConfig) { l.Packages = nil },
+			wantErr: "no packages declared",
+		},
+		{
+			name:    "rejects a duplicate name",
+			edit:    func(l *LayoutConfig) { l.Packages[1].Name = "transport" },
+			wantErr: "declared twice",
+		},
+		{
+			name:    "rejects a path outside the project",
+			edit:    func(l *LayoutConfig) { l.Packages[1].Path = "../core" },
+			wantErr: "clean path",
+		},
+		{
+			name:    "rejects a path that is not a package name",
+			edit:    func(l *LayoutConfig) { l.Packages[1].Path = "internal/my-core" },
+			wantErr: "not a valid Go package name",
+		},
+		{
+			name:    "rejects a role in a package gool generates",
+			edit:    func(l *LayoutConfig) { l.Packages[1].Path = "pkg/core" },
+			wantErr: "which gool generates itself",
+		},
+		{
+			name:    "rejects an unknown role",
+			edit:    func(l *LayoutConfig) { l.Packages[1].Role = "usecase" },
+			wantErr: "unknown role",
+		},
+		{
+			name:    "rejects a missing role",
+			edit:    func(l *LayoutConfig) { l.Packages[4].Role = "" },
+			wantErr: "no package has the route role",
+		},
+		{
+			name:    "rejects a forbidden import generated code needs",
+			edit:    func(l *LayoutConfig) { l.Packages[0].Imports = []string{"domain"} },
+			wantErr: "must be allowed to import core",
+		},
+		{
+			name:    "rejects an alias generated code imports",
+			edit:    func(l *LayoutConfig) { l.Packages[1].Alias = "config" },
+			wantErr: "alias 'config' collides",
+		},
+		{
+			name:    "rejects two roles imported under one name",
+			edit:    func(l *LayoutConfig) { l.Packages[2].Path = "internal/storage/core" },
+			wantErr: "both imported as core",
+		},
+		{
+			name: "accepts two roles told apart by an alias",
+			edit: func(l *LayoutConfig) {
+				l.Packages[2].Path = "internal/storage/core"
+				l.Packages[2].Alias = "corestore"
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			layout := validLayout()
+			tt.edit(layout)
+			err := layout.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("expected the layout to be valid, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected an error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestLayoutImportName(t *testing.T) {
+	tests := []struct {
+		name  string
+		path  string
+		alias string
+		want  string
+	}{
+		{name: "imports a package under its base name", path: "internal/storage", want: "storage"},
+		{name: "appends the role to a name generated code imports", path: "internal/db/database", want: "databaserepository"},
+		{name: "prefers an explicit alias", path: "internal/db/database", alias: "db", want: "db"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			layout := validLayout()
+			layout.Packages[2].Path = tt.path
+			layout.Packages[2].Alias = tt.alias
+			if got := layout.ImportName(RoleRepository); got != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/spf13/viper"
+)
+
+// LoadProjectConfig reads a project spec from a YAML, JSON or TOML file.
+// Keys use the same names as the yaml tags on ProjectConfig. Omitted testing,
+// docker, features and middleware keys get the defaults of the init flags.
+func LoadProjectConfig(path string) (*ProjectConfig, error) {
+	ext := strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+	switch ext {
+	case ConfigYAML, "yml", ConfigJSON, ConfigTOML:
+	default:
+		return nil, fmt.Errorf("unsupported spec format '%s'. Use a .yaml, .json or .toml file", filepath.Ext(path))
+	}
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("failed to read spec %s: %w", path, err)
+	}
+
+	cfg := &ProjectConfig{}
+	if err := v.Unmarshal(cfg, func(dc *mapstructure.DecoderConfig) {
+		dc.TagName = "yaml"
+		dc.ErrorUnused = true
+	}); err != nil {
+		return nil, fmt.Errorf("failed to decode spec %s: %w", path, err)
+	}
+
+	if !v.IsSet("testing") {
+		cfg.Testing = true
+	}
+	if !v.IsSet("docker") {
+		cfg.Docker = true
+	}
+	if !v.IsSet("features") {
+		for _, name := range DefaultFeatures {
+			if err := cfg.Features.Enable(name); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if !v.IsSet("middleware") {
+		for _, name := range DefaultMiddleware {
+			if err := cfg.Middleware.Enable(name); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return cfg, nil
+}
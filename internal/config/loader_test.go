@@ -0,0 +1,72 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadProjectConfigDefaults(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+		spec string
+		want func(t *testing.T, cfg *ProjectConfig)
+	}{
+		{
+			name: "fills in omitted keys",
+			file: "gool.yaml",
+			spec: "project_name: orders\n",
+			want: func(t *testing.T, cfg *ProjectConfig) {
+				if !cfg.Testing || !cfg.Docker {
+					t.Errorf("expected testing and docker, got %v and %v", cfg.Testing, cfg.Docker)
+				}
+				if !cfg.Features.HealthCheck || !cfg.Features.Swagger || cfg.Features.Metrics {
+					t.Errorf("expected the health and swagger features, got %+v", cfg.Features)
+				}
+				if !cfg.Middleware.CORS || !cfg.Middleware.Logging || !cfg.Middleware.ErrorHandler || cfg.Middleware.RateLimit {
+					t.Errorf("expected the cors, logging and errorhandler middleware, got %+v", cfg.Middleware)
+				}
+			},
+		},
+		{
+			name: "keeps the keys given",
+			file: "gool.yaml",
+			spec: "project_name: orders\ntesting: false\ndocker: false\nfeatures:\n  metrics: true\nmiddleware:\n  rate_limit: true\n",
+			want: func(t *testing.T, cfg *ProjectConfig) {
+				if cfg.Testing || cfg.Docker {
+					t.Errorf("expected no testing or docker, got %v and %v", cfg.Testing, cfg.Docker)
+				}
+				if cfg.Features.HealthCheck || cfg.Features.Swagger || !cfg.Features.Metrics {
+					t.Errorf("expected only the metrics feature, got %+v", cfg.Features)
+				}
+				if cfg.Middleware.CORS || cfg.Middleware.Logging || !cfg.Middleware.RateLimit {
+					t.Errorf("expected only the ratelimit middleware, got %+v", cfg.Middleware)
+				}
+			},
+		},
+		{
+			name: "fills in omitted keys of a JSON spec",
+			file: "gool.json",
+			spec: `{"project_name": "orders", "docker": false}`,
+			want: func(t *testing.T, cfg *ProjectConfig) {
+				if !cfg.Testing || cfg.Docker {
+					t.Errorf("expected testing without docker, got %v and %v", cfg.Testing, cfg.Docker)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tt.file)
+			if err := os.WriteFile(path, []byte(tt.spec), 0644); err != nil {
+				t.Fatal(err)
+			}
+			cfg, err := LoadProjectConfig(path)
+			if err != nil {
+				t.Fatalf("LoadProjectConfig failed: %v", err)
+			}
+			tt.want(t, cfg)
+		})
+	}
+}
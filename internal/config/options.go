@@ -0,0 +1,138 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Feature names used on the command line
+const (
+	FeatureWebSocket    = "websocket"
+	FeatureCaching      = "caching"
+	FeatureMessageQueue = "messagequeue"
+	FeatureHealthCheck  = "health"
+	FeatureSwagger      = "swagger"
+	FeatureStaticFiles  = "static"
+	FeatureI18n         = "i18n"
+	FeatureMetrics      = "metrics"
+	FeatureCloudConfig  = "cloud"
+	FeatureMigrations   = "migrations"
+	FeatureRBAC         = "rbac"
+	FeatureCasbin       = "casbin"
+)
+
+// Middleware names used on the command line
+const (
+	MiddlewareCORS         = "cors"
+	MiddlewareRateLimit    = "ratelimit"
+	MiddlewareLogging      = "logging"
+	MiddlewareAuth         = "auth"
+	MiddlewareErrorHandler = "errorhandler"
+)
+
+// FeatureNames lists every feature that can be enabled by name
+var FeatureNames = []string{
+	FeatureWebSocket,
+	FeatureCaching,
+	FeatureMessageQueue,
+	FeatureHealthCheck,
+	FeatureSwagger,
+	FeatureStaticFiles,
+	FeatureI18n,
+	FeatureMetrics,
+	FeatureCloudConfig,
+	FeatureMigrations,
+	FeatureRBAC,
+	FeatureCasbin,
+}
+
+// MiddlewareNames lists every middleware that can be enabled by name
+var MiddlewareNames = []string{
+	MiddlewareCORS,
+	MiddlewareRateLimit,
+	MiddlewareLogging,
+	MiddlewareAuth,
+	MiddlewareErrorHandler,
+}
+
+// DefaultFeatures are enabled when no features are chosen
+var DefaultFeatures = []string{FeatureHealthCheck, FeatureSwagger}
+
+// DefaultMiddleware is enabled when no middleware is chosen
+var DefaultMiddleware = []string{MiddlewareCORS, MiddlewareLogging, MiddlewareErrorHandler}
+
+// field returns a pointer to the feature flag with the given name
+func (f *FeaturesConfig) field(name string) *bool {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case FeatureWebSocket:
+		return &f.WebSocket
+	case FeatureCaching:
+		return &f.Caching
+	case FeatureMessageQueue:
+		return &f.MessageQueue
+	case FeatureHealthCheck:
+		return &f.HealthCheck
+	case FeatureSwagger:
+		return &f.Swagger
+	case FeatureStaticFiles:
+		return &f.StaticFiles
+	case FeatureI18n:
+		return &f.I18n
+	case FeatureMetrics:
+		return &f.Metrics
+	case FeatureCloudConfig:
+		return &f.CloudConfig
+	case FeatureMigrations:
+		return &f.Migrations
+	case FeatureRBAC:
+		return &f.RBAC
+	case FeatureCasbin:
+		return &f.Casbin
+	default:
+		return nil
+	}
+}
+
+// Enable turns on the feature with the given name
+func (f *FeaturesConfig) Enable(name string) error {
+	field := f.field(name)
+	if field == nil {
+		return fmt.Errorf("invalid feature '%s'. Valid options: %s", name, strings.Join(FeatureNames, ", "))
+	}
+	*field = true
+	return nil
+}
+
+// Enabled reports whether the feature with the given name is on
+func (f *FeaturesConfig) Enabled(name string) bool {
+	field := f.field(name)
+	return field != nil && *field
+}
+
+// field returns a pointer to the middleware flag with the given name
+func (m *MiddlewareConfig) field(name string) *bool {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case MiddlewareCORS:
+		return &m.CORS
+	case MiddlewareRateLimit:
+		return &m.RateLimit
+	case MiddlewareLogging:
+		return &m.Logging
+	case MiddlewareAuth:
+		return &m.Auth
+	case MiddlewareErrorHandler:
+		return &m.ErrorHandler
+	default:
+		return nil
+	}
+}
+
+// Enable turns on the middleware with the given name
+func (m *MiddlewareConfig) Enable(name string) error {
+	field := m.field(name)
+	if field == nil {
+		return fmt.Errorf("invalid middleware '%s'. Valid options: %s", name, strings.Join(MiddlewareNames, ", "))
+	}
+	*field = true
+	return nil
+}
@@ -0,0 +1,170 @@
+package config
+
+import "slices"
+
+// ORMOption is a database access layer projects can be generated with. The
+// prompts, validation and go.mod template all read the options from ORMs.
+type ORMOption struct {
+	Name string
+	// Icon and Description label the option in the interactive prompt
+	Icon        string
+	Description string
+	// Databases lists the databases the layer works with
+	Databases []string
+	// Requires are the go.mod requirements of the layer with any database
+	// and DatabaseRequires those it needs for one database only
+	Requires         []string
+	DatabaseRequires map[string][]string
+	// TestRequires are the extra requirements of the generated tests
+	TestRequires []string
+}
+
+var sqlDatabases = []string{DBPostgreSQL, DBMySQL, DBSQLite}
+
+// sqlDrivers are the database/sql drivers of the SQL databases
+var sqlDrivers = map[string][]string{
+	DBPostgreSQL: {"github.com/lib/pq v1.10.9"},
+	DBMySQL:      {"github.com/go-sql-driver/mysql v1.7.1"},
+	DBSQLite:     {"github.com/mattn/go-sqlite3 v1.14.18"},
+}
+
+// pureGoSQLite maps the SQLite requirements that need cgo to their pure Go
+// replacements
+var pureGoSQLite = map[string]string{
+	"github.com/mattn/go-sqlite3 v1.14.18": "modernc.org/sqlite v1.29.5",
+	"gorm.io/driver/sqlite v1.5.4":         "github.com/glebarez/sqlite v1.11.0",
+}
+
+// ORMs lists the database access layers in the order they are offered
+var ORMs = []ORMOption{
+	{
+		Name:        ORMGorm,
+		Icon:        "🏗️ ",
+		Description: "Feature-rich ORM",
+		Databases:   sqlDatabases,
+		Requires:    []string{"gorm.io/gorm v1.25.5"},
+		DatabaseRequires: map[string][]string{
+			DBPostgreSQL: {"gorm.io/driver/postgres v1.5.4"},
+			DBMySQL:      {"gorm.io/driver/mysql v1.5.2"},
+			DBSQLite:     {"gorm.io/driver/sqlite v1.5.4"},
+		},
+	},
+	{
+		Name:             ORMSqlx,
+		Icon:             "⚙️ ",
+		Description:      "Extensions on database/sql",
+		Databases:        sqlDatabases,
+		Requires:         []string{"github.com/jmoiron/sqlx v1.3.5"},
+		DatabaseRequires: sqlDrivers,
+	},
+	{
+		Name:        ORMRaw,
+		Icon:        "🔧",
+		Description: "Direct queries with the database driver",
+		Databases:   []string{DBPostgreSQL, DBMySQL, DBSQLite, DBMongoDB, DBRedis},
+		DatabaseRequires: map[string][]string{
+			DBPostgreSQL: sqlDrivers[DBPostgreSQL],
+			DBMySQL:      sqlDrivers[DBMySQL],
+			DBSQLite:     sqlDrivers[DBSQLite],
+			DBMongoDB:    {"go.mongodb.org/mongo-driver v1.17.6"},
+			DBRedis:      {"github.com/redis/go-redis/v9 v9.3.0"},
+		},
+	},
+	{
+		Name:             ORMSqlc,
+		Icon:             "🧬",
+		Description:      "Type-safe Go generated from SQL queries",
+		Databases:        sqlDatabases,
+		DatabaseRequires: sqlDrivers,
+	},
+	// ent's code generator loads the schemas with golang.org/x/tools, which
+	// must be recent enough to read the running toolchain's export data
+	{
+		Name:             ORMEnt,
+		Icon:             "🕸️ ",
+		Description:      "Entity framework with a generated, typed client",
+		Databases:        sqlDatabases,
+		Requires:         []string{"entgo.io/ent v0.14.5", "golang.org/x/tools v0.50.0"},
+		DatabaseRequires: sqlDrivers,
+		TestRequires:     sqlDrivers[DBSQLite],
+	},
+	{
+		Name:        ORMBun,
+		Icon:        "🥟",
+		Description: "SQL-first ORM",
+		Databases:   sqlDatabases,
+		Requires:    []string{"github.com/uptrace/bun v1.1.16"},
+		DatabaseRequires: map[string][]string{
+			DBPostgreSQL: {"github.com/uptrace/bun/dialect/pgdialect v1.1.16", "github.com/uptrace/bun/driver/pgdriver v1.1.16"},
+			DBMySQL:      {"github.com/uptrace/bun/dialect/mysqldialect v1.1.16", "github.com/go-sql-driver/mysql v1.7.1"},
+			DBSQLite:     {"github.com/uptrace/bun/dialect/sqlitedialect v1.1.16", "github.com/mattn/go-sqlite3 v1.14.18"},
+		},
+		TestRequires: []string{"github.com/uptrace/bun/dialect/sqlitedialect v1.1.16", "github.com/mattn/go-sqlite3 v1.14.18"},
+	},
+	{
+		Name:        ORMNone,
+		Icon:        "🚫",
+		Description: "No database, keep data in memory",
+		Databases:   []string{DBMemory},
+	},
+}
+
+// ORMNames returns the names of the database access layers
+func ORMNames() []string {
+	names := make([]string, 0, len(ORMs))
+	for _, orm := range ORMs {
+		names = append(names, orm.Name)
+	}
+	return names
+}
+
+// LookupORM returns the database access layer with the given name
+func LookupORM(name string) (ORMOption, bool) {
+	for _, orm := range ORMs {
+		if orm.Name == name {
+			return orm, true
+		}
+	}
+	return ORMOption{}, false
+}
+
+// Label returns the option as shown in the interactive prompt
+func (o ORMOption) Label() string {
+	return o.Icon + " " + o.Name + " - " + o.Description
+}
+
+// Supports reports whether the layer works with the database
+func (o ORMOption) Supports(database string) bool {
+	return slices.Contains(o.Databases, database)
+}
+
+// Modules returns the go.mod requirements of a project using the layer with
+// the database, with those of its tests when testing is set. pureGo swaps the
+// SQLite drivers for ones that build without cgo.
+func (o ORMOption) Modules(database string, testing, pureGo bool) []string {
+	modules := append([]string(nil), o.Requires...)
+	modules = append(modules, o.DatabaseRequires[database]...)
+	if testing {
+		for _, module := range o.TestRequires {
+			if !slices.Contains(modules, module) {
+				modules = append(modules, module)
+			}
+		}
+	}
+	if pureGo {
+		for i, module := range modules {
+			if replacement, ok := pureGoSQLite[module]; ok {
+				modules[i] = replacement
+			}
+		}
+	}
+	return modules
+}
+
+// PureGoSQLite reports whether the project's SQLite driver must build
+// without cgo. The Dockerfile builds with CGO_ENABLED=0 and the GitHub
+// workflow cross-compiles, which disables cgo, and the cgo drivers fail at
+// runtime in such binaries.
+func (c *ProjectConfig) PureGoSQLite() bool {
+	return c.Database == DBSQLite && (c.Docker || c.CICD == CICDGitHub)
+}
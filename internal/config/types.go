@@ -16,6 +16,28 @@ type ProjectConfig struct {
 	CICD         string           `yaml:"cicd"`
 	Middleware   MiddlewareConfig `yaml:"middleware"`
 	Features     FeaturesConfig   `yaml:"features"`
+	// Layout describes the packages of a custom architecture
+	Layout *LayoutConfig `yaml:"layout,omitempty"`
+}
+
+// LayoutConfig is a custom architecture: the project's packages, the role
+// each plays and the imports allowed between them
+type LayoutConfig struct {
+	Packages []PackageConfig `yaml:"packages"`
+}
+
+// PackageConfig is a package in a custom architecture layout
+type PackageConfig struct {
+	// Name identifies the package within the layout
+	Name string `yaml:"name"`
+	// Path is the package directory relative to the project root
+	Path string `yaml:"path"`
+	// Role is the kind of generated code placed in the package, if any
+	Role string `yaml:"role,omitempty"`
+	// Alias is the name the package is imported under
+	Alias string `yaml:"alias,omitempty"`
+	// Imports lists the packages, by name, this package may import
+	Imports []string `yaml:"imports,omitempty"`
 }
 
 // MiddlewareConfig represents middleware options
@@ -38,6 +60,9 @@ type FeaturesConfig struct {
 	I18n         bool `yaml:"i18n"`
 	Metrics      bool `yaml:"metrics"`
 	CloudConfig  bool `yaml:"cloud_config"`
+	Migrations   bool `yaml:"migrations"`
+	RBAC         bool `yaml:"rbac"`
+	Casbin       bool `yaml:"casbin"`
 }
 
 // Framework options
@@ -53,6 +78,9 @@ const (
 	ORMGorm = "gorm"
 	ORMSqlx = "sqlx"
 	ORMRaw  = "raw"
+	ORMSqlc = "sqlc"
+	ORMEnt  = "ent"
+	ORMBun  = "bun"
 	ORMNone = "none"
 )
 
@@ -75,6 +103,15 @@ const (
 	ArchCustom    = "custom"
 )
 
+// Package roles in a custom architecture layout
+const (
+	RoleHandler    = "handler"
+	RoleService    = "service"
+	RoleRepository = "repository"
+	RoleModel      = "model"
+	RoleRoute      = "route"
+)
+
 // Config format options
 const (
 	ConfigYAML = "yaml"
@@ -87,9 +124,22 @@ const (
 	AuthJWT    = "jwt"
 	AuthOAuth2 = "oauth2"
 	AuthBasic  = "basic"
+	AuthAPIKey = "apikey"
 	AuthNone   = "none"
 )
 
+// StoresUsers reports whether the auth method checks passwords against users
+// kept in the project's database. Revel projects sign in only with JWT.
+func (c *ProjectConfig) StoresUsers() bool {
+	return c.Auth == AuthJWT || (c.Framework != FrameworkRevel && c.Auth == AuthBasic)
+}
+
+// UsesAPIKeys reports whether requests authenticate with API keys whose
+// hashes are kept in the project's database
+func (c *ProjectConfig) UsesAPIKeys() bool {
+	return c.Framework != FrameworkRevel && c.Auth == AuthAPIKey
+}
+
 // Logging options
 const (
 	LogStandard = "standard"
@@ -0,0 +1,77 @@
+package diff
+
+import "strings"
+
+// SplitLines splits text into lines, keeping the trailing newline on each line
+func SplitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// match returns, for every line of a, the index of the line of b it is
+// paired with in a longest common subsequence, or -1 if it is not part of it
+func match(a, b []string) []int {
+	// Common prefix and suffix are matched directly to keep the table small
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	result := make([]int, len(a))
+	for i := range result {
+		result[i] = -1
+	}
+	for i := 0; i < prefix; i++ {
+		result[i] = i
+	}
+	for i := 0; i < suffix; i++ {
+		result[len(a)-1-i] = len(b) - 1 - i
+	}
+
+	midA := a[prefix : len(a)-suffix]
+	midB := b[prefix : len(b)-suffix]
+	if len(midA) == 0 || len(midB) == 0 {
+		return result
+	}
+
+	// lengths[i][j] holds the LCS length of midA[i:] and midB[j:]
+	cols := len(midB) + 1
+	lengths := make([]int32, (len(midA)+1)*cols)
+	for i := len(midA) - 1; i >= 0; i-- {
+		for j := len(midB) - 1; j >= 0; j-- {
+			if midA[i] == midB[j] {
+				lengths[i*cols+j] = lengths[(i+1)*cols+j+1] + 1
+			} else if lengths[(i+1)*cols+j] >= lengths[i*cols+j+1] {
+				lengths[i*cols+j] = lengths[(i+1)*cols+j]
+			} else {
+				lengths[i*cols+j] = lengths[i*cols+j+1]
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(midA) && j < len(midB) {
+		switch {
+		case midA[i] == midB[j]:
+			result[prefix+i] = prefix + j
+			i++
+			j++
+		case lengths[(i+1)*cols+j] >= lengths[i*cols+j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,113 @@
+package diff
+
+import "strings"
+
+// Labels names the three sides of a merge in conflict markers
+type Labels struct {
+	Ours   string
+	Base   string
+	Theirs string
+}
+
+// MergeResult is the outcome of a three-way merge
+type MergeResult struct {
+	Text      string
+	Conflicts int
+}
+
+// Merge3 performs a line based three-way merge of ours and theirs, both
+// derived from base. Regions changed on only one side are taken from that
+// side; regions changed differently on both sides are written with
+// diff3-style conflict markers.
+func Merge3(base, ours, theirs string, labels Labels) MergeResult {
+	baseLines := SplitLines(base)
+	ourLines := SplitLines(ours)
+	theirLines := SplitLines(theirs)
+
+	ourMatch := match(baseLines, ourLines)
+	theirMatch := match(baseLines, theirLines)
+
+	var out strings.Builder
+	conflicts := 0
+
+	i, o, t := 0, 0, 0
+	for i < len(baseLines) || o < len(ourLines) || t < len(theirLines) {
+		// Emit the run of lines that is unchanged on both sides
+		stable := 0
+		for i+stable < len(baseLines) && ourMatch[i+stable] == o+stable && theirMatch[i+stable] == t+stable {
+			stable++
+		}
+		if stable > 0 {
+			for _, line := range baseLines[i : i+stable] {
+				out.WriteString(line)
+			}
+			i, o, t = i+stable, o+stable, t+stable
+			continue
+		}
+
+		// Find the next base line kept by both sides
+		next := i
+		for next < len(baseLines) && (ourMatch[next] < 0 || theirMatch[next] < 0) {
+			next++
+		}
+		nextOurs, nextTheirs := len(ourLines), len(theirLines)
+		if next < len(baseLines) {
+			nextOurs, nextTheirs = ourMatch[next], theirMatch[next]
+		}
+
+		baseChunk := baseLines[i:next]
+		ourChunk := ourLines[o:nextOurs]
+		theirChunk := theirLines[t:nextTheirs]
+
+		switch {
+		case equalLines(ourChunk, baseChunk):
+			writeLines(&out, theirChunk)
+		case equalLines(theirChunk, baseChunk), equalLines(ourChunk, theirChunk):
+			writeLines(&out, ourChunk)
+		default:
+			conflicts++
+			writeConflict(&out, ourChunk, baseChunk, theirChunk, labels)
+		}
+
+		i, o, t = next, nextOurs, nextTheirs
+	}
+
+	return MergeResult{Text: out.String(), Conflicts: conflicts}
+}
+
+func equalLines(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func writeLines(out *strings.Builder, lines []string) {
+	for _, line := range lines {
+		out.WriteString(line)
+	}
+}
+
+// writeConflictSide writes lines making sure the block ends with a newline
+// so the following marker starts on its own line
+func writeConflictSide(out *strings.Builder, lines []string) {
+	writeLines(out, lines)
+	if len(lines) > 0 && !strings.HasSuffix(lines[len(lines)-1], "\n") {
+		out.WriteString("\n")
+	}
+}
+
+func writeConflict(out *strings.Builder, ours, base, theirs []string, labels Labels) {
+	out.WriteString("<<<<<<< " + labels.Ours + "\n")
+	writeConflictSide(out, ours)
+	out.WriteString("||||||| " + labels.Base + "\n")
+	writeConflictSide(out, base)
+	out.WriteString("=======\n")
+	writeConflictSide(out, theirs)
+	out.WriteString(">>>>>>> " + labels.Theirs + "\n")
+}
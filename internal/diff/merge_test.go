@@ -0,0 +1,132 @@
+package diff
+
+import "testing"
+
+func TestMerge3(t *testing.T) {
+	labels := Labels{Ours: "yours", Base: "base", Theirs: "gool"}
+
+	tests := []struct {
+		name      string
+		base      string
+		ours      string
+		theirs    string
+		want      string
+		conflicts int
+	}{
+		{
+			name:   "unchanged",
+			base:   "a\nb\nc\n",
+			ours:   "a\nb\nc\n",
+			theirs: "a\nb\nc\n",
+			want:   "a\nb\nc\n",
+		},
+		{
+			name:   "changed by ours",
+			base:   "a\nb\nc\n",
+			ours:   "a\nB\nc\n",
+			theirs: "a\nb\nc\n",
+			want:   "a\nB\nc\n",
+		},
+		{
+			name:   "changed by theirs",
+			base:   "a\nb\nc\n",
+			ours:   "a\nb\nc\n",
+			theirs: "a\nb\nC\n",
+			want:   "a\nb\nC\n",
+		},
+		{
+			name:   "separate changes",
+			base:   "a\nb\nc\nd\ne\n",
+			ours:   "A\nb\nc\nd\ne\n",
+			theirs: "a\nb\nc\nd\nE\n",
+			want:   "A\nb\nc\nd\nE\n",
+		},
+		{
+			name:   "same change on both sides",
+			base:   "a\nb\nc\n",
+			ours:   "a\nB\nc\n",
+			theirs: "a\nB\nc\n",
+			want:   "a\nB\nc\n",
+		},
+		{
+			name:   "deleted by ours",
+			base:   "a\nb\nc\n",
+			ours:   "a\nc\n",
+			theirs: "a\nb\nc\nd\n",
+			want:   "a\nc\nd\n",
+		},
+		{
+			name:      "overlapping changes",
+			base:      "a\nb\nc\n",
+			ours:      "a\nmine\nc\n",
+			theirs:    "a\ntheirs\nc\n",
+			want:      "a\n<<<<<<< yours\nmine\n||||||| base\nb\n=======\ntheirs\n>>>>>>> gool\nc\n",
+			conflicts: 1,
+		},
+		{
+			name:      "two overlapping changes",
+			base:      "a\nb\nc\nd\ne\n",
+			ours:      "A1\nb\nc\nd\nE1\n",
+			theirs:    "A2\nb\nc\nd\nE2\n",
+			want:      "<<<<<<< yours\nA1\n||||||| base\na\n=======\nA2\n>>>>>>> gool\nb\nc\nd\n<<<<<<< yours\nE1\n||||||| base\ne\n=======\nE2\n>>>>>>> gool\n",
+			conflicts: 2,
+		},
+		{
+			name:   "insertion at EOF by theirs",
+			base:   "a\nb\n",
+			ours:   "A\nb\n",
+			theirs: "a\nb\nc\n",
+			want:   "A\nb\nc\n",
+		},
+		{
+			name:   "insertion at EOF by ours",
+			base:   "a\nb\nc\n",
+			ours:   "a\nb\nc\nmine\n",
+			theirs: "a\nB\nc\n",
+			want:   "a\nB\nc\nmine\n",
+		},
+		{
+			// As with diff3, changes to adjacent lines overlap
+			name:      "insertion next to a change",
+			base:      "a\nb\n",
+			ours:      "a\nb\nmine\n",
+			theirs:    "a\nB\n",
+			want:      "a\n<<<<<<< yours\nb\nmine\n||||||| base\nb\n=======\nB\n>>>>>>> gool\n",
+			conflicts: 1,
+		},
+		{
+			name:      "different insertions at EOF",
+			base:      "a\nb\n",
+			ours:      "a\nb\nmine\n",
+			theirs:    "a\nb\ntheirs\n",
+			want:      "a\nb\n<<<<<<< yours\nmine\n||||||| base\n=======\ntheirs\n>>>>>>> gool\n",
+			conflicts: 1,
+		},
+		{
+			name:      "conflict without a trailing newline",
+			base:      "a\nb",
+			ours:      "a\nmine",
+			theirs:    "a\ntheirs",
+			want:      "a\n<<<<<<< yours\nmine\n||||||| base\nb\n=======\ntheirs\n>>>>>>> gool\n",
+			conflicts: 1,
+		},
+		{
+			name:   "empty base",
+			base:   "",
+			ours:   "",
+			theirs: "a\n",
+			want:   "a\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Merge3(tt.base, tt.ours, tt.theirs, labels)
+			if got.Text != tt.want {
+				t.Errorf("expected\n%q\ngot\n%q", tt.want, got.Text)
+			}
+			if got.Conflicts != tt.conflicts {
+				t.Errorf("expected %d conflicts, got %d", tt.conflicts, got.Conflicts)
+			}
+		})
+	}
+}
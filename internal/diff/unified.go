@@ -0,0 +1,114 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+// op is a single line of an edit script: ' ' kept, '-' removed or '+' added
+type op struct {
+	kind byte
+	line string
+}
+
+// Unified returns a unified diff that turns a into b, with context lines of
+// unchanged text around each change. It returns "" when a and b are equal.
+func Unified(a, b, fromLabel, toLabel string, context int) string {
+	if a == b {
+		return ""
+	}
+
+	ops := editScript(SplitLines(a), SplitLines(b))
+
+	var out strings.Builder
+	fmt.Fprintf(&out, "--- %s\n+++ %s\n", fromLabel, toLabel)
+
+	for start := 0; start < len(ops); {
+		// Find the next change and extend the hunk while changes are close
+		first := start
+		for first < len(ops) && ops[first].kind == ' ' {
+			first++
+		}
+		if first == len(ops) {
+			break
+		}
+		last := first
+		for i := first; i < len(ops); i++ {
+			if ops[i].kind == ' ' {
+				continue
+			}
+			if i-last-1 > 2*context {
+				break
+			}
+			last = i
+		}
+
+		from := max(first-context, start)
+		to := min(last+context+1, len(ops))
+		writeHunk(&out, ops, from, to)
+		start = to
+	}
+
+	return out.String()
+}
+
+// editScript pairs the lines of a and b along their longest common subsequence
+func editScript(a, b []string) []op {
+	matches := match(a, b)
+	ops := make([]op, 0, len(a)+len(b))
+
+	j := 0
+	for i, line := range a {
+		if matches[i] < 0 {
+			ops = append(ops, op{'-', line})
+			continue
+		}
+		for ; j < matches[i]; j++ {
+			ops = append(ops, op{'+', b[j]})
+		}
+		ops = append(ops, op{' ', line})
+		j++
+	}
+	for ; j < len(b); j++ {
+		ops = append(ops, op{'+', b[j]})
+	}
+
+	return ops
+}
+
+func writeHunk(out *strings.Builder, ops []op, from, to int) {
+	// Line numbers are one-based; an empty range is numbered by the line before it
+	fromLine, toLine := 1, 1
+	for _, o := range ops[:from] {
+		if o.kind != '+' {
+			fromLine++
+		}
+		if o.kind != '-' {
+			toLine++
+		}
+	}
+	fromCount, toCount := 0, 0
+	for _, o := range ops[from:to] {
+		if o.kind != '+' {
+			fromCount++
+		}
+		if o.kind != '-' {
+			toCount++
+		}
+	}
+	if fromCount == 0 {
+		fromLine--
+	}
+	if toCount == 0 {
+		toLine--
+	}
+
+	fmt.Fprintf(out, "@@ -%d,%d +%d,%d @@\n", fromLine, fromCount, toLine, toCount)
+	for _, o := range ops[from:to] {
+		out.WriteByte(o.kind)
+		out.WriteString(o.line)
+		if !strings.HasSuffix(o.line, "\n") {
+			out.WriteString("\n\\ No newline at end of file\n")
+		}
+	}
+}
@@ -0,0 +1,213 @@
+package generator
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/gool-cli/gool/internal/templates"
+	"gopkg.in/yaml.v3"
+)
+
+// embeddedTemplates holds the project templates and the manifest that maps
+// them to output files
+//
+//go:embed all:templates
+var embeddedTemplates embed.FS
+
+const (
+	// catalogManifest is the manifest's path within a template tree
+	catalogManifest = "manifest.yaml"
+	// partialsDir holds templates that other templates can include
+	partialsDir = "partials"
+)
+
+// templateEntry maps a template to an output path when its condition holds
+type templateEntry struct {
+	Template string `yaml:"template"`
+	Output   string `yaml:"output"`
+	When     string `yaml:"when,omitempty"`
+	Raw      bool   `yaml:"raw,omitempty"`
+}
+
+// templateManifest lists the templates rendered for a project and for a
+// generated resource
+type templateManifest struct {
+	Project  []templateEntry `yaml:"project"`
+	Resource []templateEntry `yaml:"resource"`
+}
+
+// catalog is a template tree and its manifest
+type catalog struct {
+	fsys     fs.FS
+	manifest templateManifest
+	partials map[string]string
+}
+
+// plannedFile is a manifest entry resolved against template data
+type plannedFile struct {
+	template string
+	output   string
+	raw      bool
+}
+
+// BuiltinTemplates returns the template tree built into gool
+func BuiltinTemplates() fs.FS {
+	sub, err := fs.Sub(embeddedTemplates, "templates")
+	if err != nil {
+		panic(err)
+	}
+	return sub
+}
+
+// loadCatalog reads the manifest and partials of the template tree in fsys
+func loadCatalog(fsys fs.FS) (*catalog, error) {
+	content, err := fs.ReadFile(fsys, catalogManifest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read template manifest: %w", err)
+	}
+
+	c := &catalog{fsys: fsys, partials: make(map[string]string)}
+	if err := yaml.Unmarshal(content, &c.manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse template manifest: %w", err)
+	}
+
+	partials, err := fs.Glob(fsys, partialsDir+"/*.tmpl")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list partials: %w", err)
+	}
+	for _, name := range partials {
+		content, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read partial %s: %w", name, err)
+		}
+		c.partials[strings.TrimSuffix(path.Base(name), ".tmpl")] = string(content)
+	}
+
+	return c, nil
+}
+
+// resolve returns the files the entries produce for data, skipping entries
+// whose condition does not hold
+func (c *catalog) resolve(engine *templates.Engine, entries []templateEntry, data interface{}) ([]plannedFile, error) {
+	var files []plannedFile
+	for _, entry := range entries {
+		if entry.When != "" {
+			holds, err := engine.Render("{{if "+entry.When+"}}true{{end}}", data)
+			if err != nil {
+				return nil, fmt.Errorf("failed to evaluate condition of %s: %w", entry.Template, err)
+			}
+			if holds != "true" {
+				continue
+			}
+		}
+
+		name, err := engine.Render(entry.Template, data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve template %s: %w", entry.Template, err)
+		}
+		output, err := engine.Render(entry.Output, data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve output of %s: %w", entry.Template, err)
+		}
+
+		files = append(files, plannedFile{template: name, output: filepath.FromSlash(output), raw: entry.Raw})
+	}
+
+	return files, nil
+}
+
+// render returns the content of a planned file
+func (c *catalog) render(engine *templates.Engine, file plannedFile, data interface{}) (string, error) {
+	content, err := engine.ReadTemplate(file.template)
+	if err != nil {
+		return "", err
+	}
+	if file.raw {
+		return content, nil
+	}
+
+	rendered, err := engine.RenderWithPartials(content, c.partials, data)
+	if err != nil {
+		return "", fmt.Errorf("failed to render %s: %w", file.template, err)
+	}
+	return rendered, nil
+}
+
+// TemplateInfo is a built-in template and the project paths it renders to
+type TemplateInfo struct {
+	Name    string
+	Outputs []string
+}
+
+// ListTemplates returns every built-in template, sorted by name, with the
+// output paths the manifest renders it to
+func ListTemplates() ([]TemplateInfo, error) {
+	builtin := BuiltinTemplates()
+	c, err := loadCatalog(builtin)
+	if err != nil {
+		return nil, err
+	}
+	entries := append(append([]templateEntry{}, c.manifest.Project...), c.manifest.Resource...)
+
+	var list []TemplateInfo
+	err = fs.WalkDir(builtin, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		info := TemplateInfo{Name: name}
+		for _, entry := range entries {
+			if matched, _ := path.Match(templatePattern(entry.Template), name); matched && !slices.Contains(info.Outputs, entry.Output) {
+				info.Outputs = append(info.Outputs, entry.Output)
+			}
+		}
+		list = append(list, info)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list templates: %w", err)
+	}
+
+	return list, nil
+}
+
+// FindTemplates returns the built-in templates matching query, which is a
+// template name, a directory of templates or an output path such as
+// pkg/logger/logger.go
+func FindTemplates(query string) ([]string, error) {
+	list, err := ListTemplates()
+	if err != nil {
+		return nil, err
+	}
+
+	query = strings.Trim(path.Clean(filepath.ToSlash(query)), "/")
+	var names []string
+	for _, info := range list {
+		if info.Name == query || strings.HasPrefix(info.Name, query+"/") || slices.Contains(info.Outputs, query) {
+			names = append(names, info.Name)
+		}
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("unknown template '%s'", query)
+	}
+
+	return names, nil
+}
+
+// templatePattern turns a manifest template path into a glob, replacing
+// each action such as {{.Framework}} with *
+func templatePattern(name string) string {
+	for {
+		start := strings.Index(name, "{{")
+		end := strings.Index(name, "}}")
+		if start < 0 || end < start {
+			return name
+		}
+		name = name[:start] + "*" + name[end+2:]
+	}
+}
@@ -2,31 +2,54 @@ package generator
 
 import (
 	"fmt"
-	"os"
+	"io/fs"
+	"path"
 	"path/filepath"
+	"strings"
 
 	"github.com/gool-cli/gool/internal/config"
+	"github.com/gool-cli/gool/internal/manifest"
 	"github.com/gool-cli/gool/internal/templates"
+	"github.com/spf13/afero"
 )
 
+// projectData is the template data for a project
+type projectData struct {
+	*templates.TemplateData
+	Layout Layout
+	// StarterUser is set when the User model and its slice come from the
+	// resource templates rather than base/models
+	StarterUser bool
+}
+
 // Generator handles project generation
 type Generator struct {
 	templateEngine *templates.Engine
 }
 
-// New creates a new generator instance
+// New creates a new generator instance that writes to disk
 func New() *Generator {
+	return NewFs(afero.NewOsFs())
+}
+
+// NewFs creates a new generator instance that writes to fs
+func NewFs(fs afero.Fs) *Generator {
+	engine := templates.NewEngineFs(fs)
+	engine.UseTemplates(BuiltinTemplates())
 	return &Generator{
-		templateEngine: templates.NewEngine(),
+		templateEngine: engine,
 	}
 }
 
 // Generate creates a new project based on the provided configuration
 func (g *Generator) Generate(cfg *config.ProjectConfig) error {
-	projectPath := filepath.Join(".", cfg.ProjectName)
+	return g.GenerateAt(cfg, filepath.Join(".", cfg.ProjectName))
+}
 
+// GenerateAt creates a new project in the given directory
+func (g *Generator) GenerateAt(cfg *config.ProjectConfig, projectPath string) error {
 	// Create project directory
-	if err := os.MkdirAll(projectPath, 0755); err != nil {
+	if err := g.templateEngine.MkdirAll(projectPath); err != nil {
 		return fmt.Errorf("failed to create project directory: %w", err)
 	}
 
@@ -35,78 +58,87 @@ func (g *Generator) Generate(cfg *config.ProjectConfig) error {
 		return fmt.Errorf("failed to generate directory structure: %w", err)
 	}
 
-	// Generate core files
-	if err := g.generateCoreFiles(cfg, projectPath); err != nil {
-		return fmt.Errorf("failed to generate core files: %w", err)
-	}
-
-	// Generate framework-specific files
-	if err := g.generateFrameworkFiles(cfg, projectPath); err != nil {
-		return fmt.Errorf("failed to generate framework files: %w", err)
+	// Render every template the manifest selects for this configuration
+	if err := g.generateFiles(cfg, projectPath); err != nil {
+		return fmt.Errorf("failed to generate project files: %w", err)
 	}
 
-	// Generate database files
-	if err := g.generateDatabaseFiles(cfg, projectPath); err != nil {
-		return fmt.Errorf("failed to generate database files: %w", err)
+	// Record how the project was generated
+	if err := g.writeManifest(cfg, projectPath); err != nil {
+		return fmt.Errorf("failed to write manifest: %w", err)
 	}
 
-	// Generate middleware files
-	if err := g.generateMiddlewareFiles(cfg, projectPath); err != nil {
-		return fmt.Errorf("failed to generate middleware files: %w", err)
-	}
+	return nil
+}
 
-	// Generate feature files
-	if err := g.generateFeatureFiles(cfg, projectPath); err != nil {
-		return fmt.Errorf("failed to generate feature files: %w", err)
+// generateFiles renders the project templates selected by the manifest
+func (g *Generator) generateFiles(cfg *config.ProjectConfig, projectPath string) error {
+	c, err := loadCatalog(g.templateEngine.Templates())
+	if err != nil {
+		return err
 	}
-
-	// Generate beautiful startup
-	if err := g.generateBeautifulStartup(cfg, projectPath); err != nil {
-		return fmt.Errorf("failed to generate beautiful startup: %w", err)
+	if _, err := fs.Stat(c.fsys, path.Join("framework", cfg.Framework)); err != nil {
+		return fmt.Errorf("unsupported framework: %s", cfg.Framework)
 	}
 
-	// Generate docs package (only if Swagger is enabled)
-	if err := g.generateDocsPackage(cfg, projectPath); err != nil {
-		return fmt.Errorf("failed to generate docs package: %w", err)
+	data := &projectData{TemplateData: templates.NewTemplateData(cfg), Layout: LayoutFor(cfg), StarterUser: hasStarterUser(cfg)}
+	files, err := c.resolve(g.templateEngine, c.manifest.Project, data)
+	if err != nil {
+		return err
 	}
 
-	// Generate models
-	if err := g.generateModels(cfg, projectPath); err != nil {
-		return fmt.Errorf("failed to generate models: %w", err)
+	for _, file := range files {
+		content, err := c.render(g.templateEngine, file, data)
+		if err != nil {
+			return err
+		}
+		if err := g.templateEngine.WriteFile(filepath.Join(projectPath, file.output), content); err != nil {
+			return err
+		}
 	}
 
-	// Generate configuration files
-	if err := g.generateConfigFiles(cfg, projectPath); err != nil {
-		return fmt.Errorf("failed to generate config files: %w", err)
+	resources, err := starterResources(cfg)
+	if err != nil {
+		return err
 	}
-
-	// Generate testing files
-	if cfg.Testing {
-		if err := g.generateTestFiles(cfg, projectPath); err != nil {
-			return fmt.Errorf("failed to generate test files: %w", err)
+	for _, res := range resources {
+		data := newResourceData(cfg, res)
+		if data.Migration, err = g.nextMigration(projectPath); err != nil {
+			return err
 		}
-	}
-
-	// Generate Docker files
-	if cfg.Docker {
-		if err := g.generateDockerFiles(cfg, projectPath); err != nil {
-			return fmt.Errorf("failed to generate Docker files: %w", err)
+		if _, err := g.generateResourceFiles(c, projectPath, data); err != nil {
+			return fmt.Errorf("failed to generate resource %s: %w", res.Name, err)
 		}
 	}
 
-	// Generate CI/CD files
-	if cfg.CICD != config.CICDNone {
-		if err := g.generateCICDFiles(cfg, projectPath); err != nil {
-			return fmt.Errorf("failed to generate CI/CD files: %w", err)
-		}
-	}
+	return nil
+}
 
-	// Generate README
-	if err := g.generateREADME(cfg, projectPath); err != nil {
-		return fmt.Errorf("failed to generate README: %w", err)
+// writeManifest records the configuration and a content hash of every
+// generated file in the project's manifest, and keeps a pristine copy of
+// each file for later upgrades
+func (g *Generator) writeManifest(cfg *config.ProjectConfig, projectPath string) error {
+	m := manifest.New(cfg)
+	resources, err := starterResources(cfg)
+	if err != nil {
+		return err
+	}
+	for _, res := range resources {
+		m.Resources = append(m.Resources, manifest.Resource{Name: res.Name, Fields: res.Specs()})
+	}
+	for filePath, content := range g.templateEngine.Written() {
+		rel, err := filepath.Rel(projectPath, filePath)
+		if err != nil || strings.HasPrefix(rel, "..") {
+			continue
+		}
+		rel = filepath.ToSlash(rel)
+		m.Files[rel] = manifest.Hash(content)
+		if err := manifest.WriteBase(g.templateEngine.Fs(), projectPath, rel, content); err != nil {
+			return err
+		}
 	}
 
-	return nil
+	return m.Write(g.templateEngine.Fs(), projectPath)
 }
 
 // generateDirectoryStructure creates the folder structure based on architecture
@@ -139,7 +171,6 @@ func (g *Generator) generateDirectoryStructure(cfg *config.ProjectConfig, projec
 	case config.ArchClean:
 		dirs = []string{
 			"cmd",
-			"internal/controller",
 			"internal/usecase",
 			"internal/repository",
 			"internal/entity",
@@ -147,7 +178,6 @@ func (g *Generator) generateDirectoryStructure(cfg *config.ProjectConfig, projec
 			"pkg/config",
 			"pkg/database",
 			"pkg/logger",
-			"api/routes",
 			"scripts",
 			"deployments",
 		}
@@ -170,7 +200,6 @@ func (g *Generator) generateDirectoryStructure(cfg *config.ProjectConfig, projec
 			"pkg/config",
 			"pkg/database",
 			"pkg/logger",
-			"api/routes",
 			"scripts",
 			"deployments",
 		}
@@ -187,12 +216,13 @@ func (g *Generator) generateDirectoryStructure(cfg *config.ProjectConfig, projec
 			"cmd",
 			"internal/controllers",
 			"internal/models",
+			"internal/repositories",
+			"internal/services",
 			"internal/views",
 			"internal/middleware",
 			"pkg/config",
 			"pkg/database",
 			"pkg/logger",
-			"api/routes",
 			"scripts",
 			"deployments",
 		}
@@ -215,6 +245,12 @@ func (g *Generator) generateDirectoryStructure(cfg *config.ProjectConfig, projec
 			"scripts",
 			"deployments",
 		}
+		// A layout file declares the project's own packages
+		if cfg.Layout != nil {
+			for _, pkg := range cfg.Layout.Packages {
+				dirs = append(dirs, pkg.Path)
+			}
+		}
 		// Add docs directory only if Swagger is enabled
 		if cfg.Features.Swagger {
 			dirs = append(dirs, "docs")
@@ -224,6 +260,11 @@ func (g *Generator) generateDirectoryStructure(cfg *config.ProjectConfig, projec
 		}
 	}
 
+	// Revel expects its views and public assets next to the app package
+	if cfg.Framework == config.FrameworkRevel {
+		dirs = append(dirs, "app/views", "public")
+	}
+
 	// Add feature-specific directories
 	if cfg.Features.StaticFiles {
 		dirs = append(dirs, "static/css", "static/js", "static/images")
@@ -234,9 +275,8 @@ func (g *Generator) generateDirectoryStructure(cfg *config.ProjectConfig, projec
 
 	// Create all directories
 	for _, dir := range dirs {
-		dirPath := filepath.Join(projectPath, dir)
-		if err := os.MkdirAll(dirPath, 0755); err != nil {
-			return fmt.Errorf("failed to create directory %s: %w", dir, err)
+		if err := g.templateEngine.MkdirAll(filepath.Join(projectPath, dir)); err != nil {
+			return err
 		}
 	}
 
@@ -0,0 +1,147 @@
+package generator
+
+import (
+	"path"
+
+	"github.com/gool-cli/gool/internal/config"
+)
+
+// Role is a package that plays a part in the project's architecture
+type Role struct {
+	// Dir is the package directory relative to the project root
+	Dir string
+	// Name is the package name
+	Name string
+	// Alias is the name the package is imported under when Name would
+	// collide with another import
+	Alias string
+}
+
+// Ref returns the identifier the package is referred to by when imported
+func (r Role) Ref() string {
+	if r.Alias != "" {
+		return r.Alias
+	}
+	return r.Name
+}
+
+// Import returns the import spec for the package
+func (r Role) Import(modulePath string) string {
+	spec := `"` + modulePath + "/" + r.Dir + `"`
+	if r.Alias != "" {
+		spec = r.Alias + " " + spec
+	}
+	return spec
+}
+
+// Layout maps the roles of generated code to packages
+type Layout struct {
+	Model Role
+	// Port holds the interfaces between layers; only hexagonal projects have one
+	Port       Role
+	Repository Role
+	Service    Role
+	Handler    Role
+	// Route registers the handlers' routes
+	Route Role
+	// Layered is set for architectures that build the application from
+	// these packages, wired together in internal/app
+	Layered bool
+}
+
+// HasPorts reports whether interfaces live in a separate ports package
+func (l Layout) HasPorts() bool {
+	return l.Port.Dir != ""
+}
+
+// RoutesFile returns the file, relative to the project root, that registers
+// the project's routes
+func (l Layout) RoutesFile() string {
+	if l.Layered {
+		return l.Route.Dir + "/wire.go"
+	}
+	return l.Route.Dir + "/routes.go"
+}
+
+// routesFunc returns the function in RoutesFile that registers the routes
+func (l Layout) routesFunc() string {
+	if l.Layered {
+		return "registerRoutes"
+	}
+	return "SetupRoutes"
+}
+
+// LayoutFor returns the package layout of a project's architecture, read
+// from its layout file for custom architectures that have one
+func LayoutFor(cfg *config.ProjectConfig) Layout {
+	if cfg.Architecture == config.ArchCustom && cfg.Layout != nil {
+		return customLayout(cfg.Layout)
+	}
+
+	switch cfg.Architecture {
+	case config.ArchClean:
+		return Layout{
+			Model:      Role{Dir: "internal/entity", Name: "entity"},
+			Repository: Role{Dir: "internal/repository", Name: "repository"},
+			Service:    Role{Dir: "internal/usecase", Name: "usecase"},
+			Handler:    Role{Dir: "internal/delivery/http", Name: "http", Alias: "httpdelivery"},
+			Route:      Role{Dir: "internal/app", Name: "app"},
+			Layered:    true,
+		}
+	case config.ArchHexagonal:
+		return Layout{
+			Model:      Role{Dir: "internal/domain/entities", Name: "entities"},
+			Port:       Role{Dir: "internal/ports", Name: "ports"},
+			Repository: Role{Dir: "internal/adapters/secondary/database", Name: "database", Alias: "dbadapter"},
+			Service:    Role{Dir: "internal/domain/services", Name: "services"},
+			Handler:    Role{Dir: "internal/adapters/primary/http", Name: "http", Alias: "httpadapter"},
+			Route:      Role{Dir: "internal/app", Name: "app"},
+			Layered:    true,
+		}
+	case config.ArchMVC:
+		return Layout{
+			Model:      Role{Dir: "internal/models", Name: "models"},
+			Repository: Role{Dir: "internal/repositories", Name: "repositories"},
+			Service:    Role{Dir: "internal/services", Name: "services"},
+			Handler:    Role{Dir: "internal/controllers", Name: "controllers"},
+			Route:      Role{Dir: "internal/app", Name: "app"},
+			Layered:    true,
+		}
+	default:
+		layout := Layout{
+			Model:      Role{Dir: "internal/models", Name: "models"},
+			Repository: Role{Dir: "internal/repositories", Name: "repositories"},
+			Service:    Role{Dir: "internal/services", Name: "services"},
+			Handler:    Role{Dir: "internal/handlers", Name: "handlers"},
+			Route:      Role{Dir: "api/routes", Name: "routes"},
+		}
+		// Revel routes requests to the controllers in app/controllers
+		if cfg.Framework == config.FrameworkRevel {
+			layout.Handler = Role{Dir: "app/controllers", Name: "controllers"}
+		}
+		return layout
+	}
+}
+
+// customLayout maps each role to the package a layout file declares for it
+func customLayout(layout *config.LayoutConfig) Layout {
+	role := func(name string) Role {
+		pkg := layout.Package(name)
+		if pkg == nil {
+			return Role{}
+		}
+		r := Role{Dir: pkg.Path, Name: path.Base(pkg.Path)}
+		if ref := layout.ImportName(name); ref != r.Name {
+			r.Alias = ref
+		}
+		return r
+	}
+
+	return Layout{
+		Model:      role(config.RoleModel),
+		Repository: role(config.RoleRepository),
+		Service:    role(config.RoleService),
+		Handler:    role(config.RoleHandler),
+		Route:      role(config.RoleRoute),
+	}
+}
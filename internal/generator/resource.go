@@ -0,0 +1,685 @@
+package generator
+
+import (
+	"fmt"
+	"go/format"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/gool-cli/gool/internal/astpatch"
+	"github.com/gool-cli/gool/internal/config"
+	"github.com/gool-cli/gool/internal/resource"
+	"github.com/gool-cli/gool/internal/templates"
+	"github.com/spf13/afero"
+)
+
+// resourceData is the template data for a generated resource
+type resourceData struct {
+	*templates.TemplateData
+	Resource *resource.Resource
+	Layout   Layout
+	// Model, NotFound and Invalid are the model type and its errors as
+	// referenced from other packages
+	Model    string
+	NotFound string
+	Invalid  string
+	// RepositoryIface and ServiceIface are the interfaces as referenced from
+	// the packages that consume them
+	RepositoryIface string
+	ServiceIface    string
+	// RepositoryReturn and ServiceReturn are the interfaces as referenced
+	// from their implementations
+	RepositoryReturn string
+	ServiceReturn    string
+	// HTTP is the name net/http is imported under in the handler package
+	HTTP string
+	// Routes registers the handler h on the router group api
+	Routes string
+	SQL    sqlStatements
+	// Migration is the version of the migration creating the resource's
+	// table, e.g. 0002
+	Migration string
+}
+
+// sqlStatements are the queries used by the sqlx and database/sql repositories
+type sqlStatements struct {
+	List, Get, Insert, Update, Delete string
+	InsertArgs, UpdateArgs, ScanArgs  string
+	Returning                         bool
+	// GetBy selects a row by the column of a unique field
+	GetBy map[string]string
+}
+
+// GenerateResource writes a CRUD slice for res into the project at
+// projectPath and registers its routes. It returns the paths it wrote,
+// relative to the project.
+func (g *Generator) GenerateResource(cfg *config.ProjectConfig, projectPath string, res *resource.Resource) ([]string, error) {
+	if cfg.Framework == config.FrameworkRevel {
+		return nil, fmt.Errorf("generating resources is not supported for revel projects")
+	}
+
+	c, err := loadCatalog(g.templateEngine.Templates())
+	if err != nil {
+		return nil, err
+	}
+
+	data := newResourceData(cfg, res)
+	if data.Migration, err = g.nextMigration(projectPath); err != nil {
+		return nil, err
+	}
+	written, err := g.generateResourceFiles(c, projectPath, data)
+	if err != nil {
+		return nil, err
+	}
+
+	patched, err := g.registerResourceRoutes(projectPath, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(written, patched...), nil
+}
+
+// starterResources returns the resources generated with a new project.
+// Layered architectures start with a User slice built from the resource
+// templates, which internal/app/wire.go registers. So do projects whose auth
+// method stores users, whose routes look users up by email through it. RBAC
+// projects keep each user's role on it, and revel projects serve it from
+// their Users controller. Projects authenticating with API keys keep them in
+// an internal APIKey resource, found by their prefix.
+func starterResources(cfg *config.ProjectConfig) ([]*resource.Resource, error) {
+	var resources []*resource.Resource
+	if hasStarterUser(cfg) {
+		specs := []string{"name:string:required", "email:string:required:unique"}
+		if cfg.StoresUsers() {
+			specs = append(specs, "password_hash:string:private")
+		}
+		if cfg.Features.RBAC {
+			specs = append(specs, "role:string:index")
+		}
+		user, err := resource.Parse("User", specs)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, user)
+	}
+
+	if cfg.UsesAPIKeys() {
+		// last_used holds Unix seconds rather than a time, which MySQL
+		// could not store as zero for keys that were never used
+		key, err := resource.Parse("APIKey", []string{
+			"name:string:required", "prefix:string:required:unique", "key_hash:string:private",
+			"scopes:string", "last_used:int64", "revoked:bool",
+		})
+		if err != nil {
+			return nil, err
+		}
+		key.Internal = true
+		resources = append(resources, key)
+	}
+	return resources, nil
+}
+
+// hasStarterUser reports whether the project starts with a User slice
+func hasStarterUser(cfg *config.ProjectConfig) bool {
+	return cfg.Framework == config.FrameworkRevel || LayoutFor(cfg).Layered || cfg.StoresUsers()
+}
+
+// generateResourceFiles renders the resource templates for data into the
+// project. It returns the paths it wrote, relative to the project.
+func (g *Generator) generateResourceFiles(c *catalog, projectPath string, data *resourceData) ([]string, error) {
+	files, err := c.resolve(g.templateEngine, c.manifest.Resource, data)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range files {
+		if _, err := g.templateEngine.Fs().Stat(filepath.Join(projectPath, file.output)); err == nil {
+			return nil, fmt.Errorf("%s already exists", filepath.ToSlash(file.output))
+		}
+	}
+
+	var written []string
+	for _, file := range files {
+		content, err := c.render(g.templateEngine, file, data)
+		if err != nil {
+			return nil, err
+		}
+		outputPath := filepath.Join(projectPath, file.output)
+		if filepath.Ext(file.output) == ".go" {
+			err = g.writeGoFile(content, outputPath)
+		} else {
+			err = g.templateEngine.WriteFile(outputPath, content)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate %s: %w", filepath.ToSlash(file.output), err)
+		}
+		written = append(written, filepath.ToSlash(file.output))
+	}
+
+	return written, nil
+}
+
+// writeGoFile writes Go source gofmt'd
+func (g *Generator) writeGoFile(content, filePath string) error {
+	formatted, err := format.Source([]byte(content))
+	if err != nil {
+		return fmt.Errorf("rendered code does not parse: %w", err)
+	}
+
+	return g.templateEngine.WriteFile(filePath, string(formatted))
+}
+
+// nextMigration returns the version following the highest numbered file in
+// the project's migrations directory
+func (g *Generator) nextMigration(projectPath string) (string, error) {
+	entries, err := afero.ReadDir(g.templateEngine.Fs(), filepath.Join(projectPath, "migrations"))
+	if err != nil && !os.IsNotExist(err) {
+		return "", fmt.Errorf("failed to read migrations: %w", err)
+	}
+
+	latest := 0
+	for _, entry := range entries {
+		digits := strings.SplitN(entry.Name(), "_", 2)[0]
+		if version, err := strconv.Atoi(digits); err == nil && version > latest {
+			latest = version
+		}
+	}
+	return fmt.Sprintf("%04d", latest+1), nil
+}
+
+// registerResourceRoutes wires the resource's handler into the project's
+// routes file, behind the middleware guarding the project's own routes, and
+// annotates its endpoints in Access in RBAC projects. It returns the paths
+// it patched, relative to the project.
+func (g *Generator) registerResourceRoutes(projectPath string, data *resourceData) ([]string, error) {
+	res := data.Resource
+	layout := data.Layout
+	routesFile := layout.RoutesFile()
+	routesPath := filepath.Join(projectPath, filepath.FromSlash(routesFile))
+	src, err := afero.ReadFile(g.templateEngine.Fs(), routesPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", routesFile, err)
+	}
+
+	repository := layout.Repository.Ref() + ".New" + res.Name + "Repository()"
+	imports := []string{
+		layout.Handler.Import(data.ModulePath),
+		layout.Service.Import(data.ModulePath),
+		layout.Repository.Import(data.ModulePath),
+	}
+	if data.ORM != config.ORMNone {
+		repository = layout.Repository.Ref() + ".New" + res.Name + "Repository(database.GetDB())"
+		imports = append(imports, `"`+data.ModulePath+`/pkg/database"`)
+	}
+
+	guard := resourceGuard(data.Config, res)
+	handler := res.Var() + "Handler"
+	stmts := fmt.Sprintf("// %s routes\n%s%s := %s.New%sHandler(%s.New%sService(%s))\n%s",
+		res.Name, guard.setup, handler, layout.Handler.Ref(), res.Name, layout.Service.Ref(), res.Name, repository,
+		routeStatements(data.Framework, res, "api", handler, guard))
+
+	patched, err := astpatch.AppendToScope(src, layout.routesFunc(), guard.scope, stmts, imports)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register routes in %s: %w", routesFile, err)
+	}
+	if err := g.templateEngine.WriteFile(routesPath, string(patched)); err != nil {
+		return nil, err
+	}
+	files := []string{routesFile}
+
+	if data.Config.Features.RBAC {
+		annotated, err := g.annotateAccess(projectPath, data)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, annotated...)
+	}
+	return files, nil
+}
+
+// routeGuard is the middleware registered in front of a resource's handlers
+type routeGuard struct {
+	// scope is a variable declared in the block the routes are registered
+	// in, next to the middleware
+	scope string
+	// setup declares the resource's own middleware
+	setup string
+	// reads guard the GET routes and writes the others
+	reads, writes []string
+}
+
+// resourceGuard returns the middleware guarding res like the project's users
+// routes. RBAC projects authorize the permissions annotated in Access, API
+// keys need the resource's read or write scope, and JWT projects need a
+// signed in user.
+func resourceGuard(cfg *config.ProjectConfig, res *resource.Resource) routeGuard {
+	switch {
+	case cfg.Features.RBAC:
+		guard := []string{"authenticate", "authorize"}
+		return routeGuard{scope: "authorize", reads: guard, writes: guard}
+	case cfg.UsesAPIKeys():
+		reads, writes := res.Var()+"Reads", res.Var()+"Writes"
+		read, write := ResourceScopes(res)
+		return routeGuard{
+			scope: "keyService",
+			setup: fmt.Sprintf("%s := middleware.APIKeyAuth(keyService, %q)\n%s := middleware.APIKeyAuth(keyService, %q)\n",
+				reads, read, writes, write),
+			reads:  []string{reads},
+			writes: []string{writes},
+		}
+	case cfg.Auth == config.AuthJWT:
+		return signedIn("tokens", "middleware.JWTAuth(tokens)")
+	}
+	return routeGuard{scope: "api"}
+}
+
+// signedIn guards every route with middleware, which authenticates users
+// with what is declared as scope
+func signedIn(scope, middleware string) routeGuard {
+	guard := []string{middleware}
+	return routeGuard{scope: scope, reads: guard, writes: guard}
+}
+
+// ResourceScopes returns the API key scopes reading and writing res take
+func ResourceScopes(res *resource.Resource) (read, write string) {
+	return res.Table() + ":read", res.Table() + ":write"
+}
+
+// literalPatch adds elements to the composite literal assigned to a variable
+// in a project file
+type literalPatch struct {
+	file, name string
+	elts       []string
+}
+
+// annotateAccess annotates the resource's endpoints in Access with the
+// resource's read, write and delete permissions, and adds them to Access's
+// test. It returns the paths it patched, relative to the project.
+func (g *Generator) annotateAccess(projectPath string, data *resourceData) ([]string, error) {
+	res := data.Resource
+	collection := "/api/v1/" + res.Path()
+	member := collection + "/:id"
+	permissions := [][3]string{
+		{"GET", collection, "read"},
+		{"GET", member, "read"},
+		{"POST", collection, "write"},
+		{"PUT", member, "write"},
+		{"DELETE", member, "delete"},
+	}
+
+	var access, tests []string
+	for _, p := range permissions {
+		endpoint := p[0] + " " + p[1]
+		access = append(access, fmt.Sprintf("%q: %q", endpoint, res.Table()+":"+p[2]))
+		// Only the admin role is granted permissions on new resources
+		tests = append(tests, fmt.Sprintf("{%q, []string{\"admin\"}}", endpoint))
+	}
+
+	// access_test.go fails for endpoints annotated in Access but not tested
+	patches := []literalPatch{{file: data.Layout.Route.Dir + "/access.go", name: "Access", elts: access}}
+	if data.Config.Testing {
+		patches = append(patches, literalPatch{file: data.Layout.Route.Dir + "/access_test.go", name: "tests", elts: tests})
+	}
+
+	var files []string
+	for _, patch := range patches {
+		filePath := filepath.Join(projectPath, filepath.FromSlash(patch.file))
+		src, err := afero.ReadFile(g.templateEngine.Fs(), filePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", patch.file, err)
+		}
+		patched, err := astpatch.AppendToLiteral(src, patch.name, patch.elts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to annotate %s in %s: %w", res.Name, patch.file, err)
+		}
+		if err := g.templateEngine.WriteFile(filePath, string(patched)); err != nil {
+			return nil, err
+		}
+		files = append(files, patch.file)
+	}
+	return files, nil
+}
+
+// routeStatements returns the statements registering handler on group,
+// behind guard
+func routeStatements(framework string, res *resource.Resource, group, handler string, guard routeGuard) string {
+	get, post, put, del := "GET", "POST", "PUT", "DELETE"
+	if framework == config.FrameworkFiber {
+		get, post, put, del = "Get", "Post", "Put", "Delete"
+	}
+
+	// Echo takes its middleware after the handler, gin and fiber before it
+	route := func(method, path, action string, middleware []string) string {
+		args := append([]string{fmt.Sprintf("%q", path)}, middleware...)
+		args = append(args, handler+"."+action)
+		if framework == config.FrameworkEcho {
+			args = append([]string{fmt.Sprintf("%q", path), handler + "." + action}, middleware...)
+		}
+		return fmt.Sprintf("%s.%s(%s)", group, method, strings.Join(args, ", "))
+	}
+
+	collection := "/" + res.Path()
+	member := collection + "/:id"
+	lines := []string{
+		route(get, collection, "List", guard.reads),
+		route(get, member, "Get", guard.reads),
+		route(post, collection, "Create", guard.writes),
+		route(put, member, "Update", guard.writes),
+		route(del, member, "Delete", guard.writes),
+	}
+	return strings.Join(lines, "\n")
+}
+
+func newResourceData(cfg *config.ProjectConfig, res *resource.Resource) *resourceData {
+	layout := LayoutFor(cfg)
+	model := layout.Model.Ref() + "."
+
+	data := &resourceData{
+		TemplateData:     templates.NewTemplateData(cfg),
+		Resource:         res,
+		Layout:           layout,
+		Model:            model + res.Name,
+		NotFound:         model + "Err" + res.Name + "NotFound",
+		Invalid:          model + "ErrInvalid" + res.Name,
+		RepositoryIface:  layout.Repository.Ref() + "." + res.Name + "Repository",
+		ServiceIface:     layout.Service.Ref() + "." + res.Name + "Service",
+		RepositoryReturn: res.Name + "Repository",
+		ServiceReturn:    res.Name + "Service",
+		HTTP:             "http",
+		Routes:           routeStatements(cfg.Framework, res, "api", "h", routeGuard{}),
+		SQL:              newSQLStatements(cfg.Database, res),
+	}
+	if layout.HasPorts() {
+		data.RepositoryIface = layout.Port.Ref() + "." + res.Name + "Repository"
+		data.ServiceIface = layout.Port.Ref() + "." + res.Name + "Service"
+		data.RepositoryReturn = data.RepositoryIface
+		data.ServiceReturn = data.ServiceIface
+	}
+	if layout.Handler.Name == "http" {
+		data.HTTP = "nethttp"
+	}
+
+	return data
+}
+
+func newSQLStatements(database string, res *resource.Resource) sqlStatements {
+	placeholder := func(i int) string {
+		if database == config.DBPostgreSQL {
+			return fmt.Sprintf("$%d", i)
+		}
+		return "?"
+	}
+
+	v := res.Var()
+	columns := []string{"id"}
+	scanArgs := []string{"&" + v + ".ID"}
+	var inserts, values, sets, insertArgs, updateArgs []string
+	for _, field := range res.Fields {
+		columns = append(columns, field.Column)
+		scanArgs = append(scanArgs, "&"+v+"."+field.Name)
+		inserts = append(inserts, field.Column)
+		values = append(values, placeholder(len(values)+1))
+		sets = append(sets, field.Column+" = "+placeholder(len(sets)+1))
+		insertArgs = append(insertArgs, v+"."+field.Name)
+		updateArgs = append(updateArgs, v+"."+field.Name)
+	}
+	columns = append(columns, "created_at", "updated_at")
+	scanArgs = append(scanArgs, "&"+v+".CreatedAt", "&"+v+".UpdatedAt")
+	inserts = append(inserts, "created_at", "updated_at")
+	values = append(values, placeholder(len(values)+1), placeholder(len(values)+2))
+	insertArgs = append(insertArgs, v+".CreatedAt", v+".UpdatedAt")
+	sets = append(sets, "updated_at = "+placeholder(len(sets)+1))
+	updateArgs = append(updateArgs, v+".UpdatedAt", v+".ID")
+
+	table := res.Table()
+	selectAll := "SELECT " + strings.Join(columns, ", ") + " FROM " + table
+	stmts := sqlStatements{
+		List:       selectAll + " ORDER BY id",
+		Get:        selectAll + " WHERE id = " + placeholder(1),
+		Insert:     "INSERT INTO " + table + " (" + strings.Join(inserts, ", ") + ") VALUES (" + strings.Join(values, ", ") + ")",
+		Update:     "UPDATE " + table + " SET " + strings.Join(sets, ", ") + " WHERE id = " + placeholder(len(sets)+1),
+		Delete:     "DELETE FROM " + table + " WHERE id = " + placeholder(1),
+		InsertArgs: strings.Join(insertArgs, ", "),
+		UpdateArgs: strings.Join(updateArgs, ", "),
+		ScanArgs:   strings.Join(scanArgs, ", "),
+		Returning:  database == config.DBPostgreSQL,
+		GetBy:      make(map[string]string),
+	}
+	for _, field := range res.UniqueFields() {
+		stmts.GetBy[field.Column] = selectAll + " WHERE " + field.Column + " = " + placeholder(1)
+	}
+	if stmts.Returning {
+		stmts.Insert += " RETURNING id"
+	}
+	return stmts
+}
+
+// FieldTags returns the struct tags of a model field
+func (d *resourceData) FieldTags(field resource.Field) string {
+	key, value := "db", field.Column
+	switch {
+	case d.Database == config.DBMongoDB:
+		key = "bson"
+	case d.ORM == config.ORMBun:
+		key = "bun"
+		if field.Required {
+			value += ",notnull"
+		}
+		if field.Unique {
+			value += ",unique"
+		}
+	}
+	name := field.Column
+	if field.Private {
+		name = "-"
+	}
+	tags := fmt.Sprintf(`json:"%s" %s:"%s"`, name, key, value)
+	if d.ORM == config.ORMGorm {
+		var gorm []string
+		switch {
+		case field.Kind == "text":
+			gorm = append(gorm, "type:text")
+		case field.GoType == "string" && (field.Unique || field.Index):
+			gorm = append(gorm, "size:255")
+		}
+		if field.Required {
+			gorm = append(gorm, "not null")
+		}
+		if field.Unique {
+			gorm = append(gorm, "uniqueIndex")
+		} else if field.Index {
+			gorm = append(gorm, "index")
+		}
+		if len(gorm) > 0 {
+			tags += fmt.Sprintf(` gorm:"%s"`, strings.Join(gorm, ";"))
+		}
+	}
+	return "`" + tags + "`"
+}
+
+// ColumnType returns the SQL type of a field's column in the project's
+// database
+func (d *resourceData) ColumnType(field resource.Field) string {
+	types := map[string][3]string{
+		// postgresql, mysql, sqlite
+		"string":  {"VARCHAR(255)", "VARCHAR(255)", "TEXT"},
+		"text":    {"TEXT", "TEXT", "TEXT"},
+		"int":     {"BIGINT", "BIGINT", "INTEGER"},
+		"int32":   {"INTEGER", "INT", "INTEGER"},
+		"int64":   {"BIGINT", "BIGINT", "INTEGER"},
+		"uint":    {"BIGINT", "BIGINT UNSIGNED", "INTEGER"},
+		"float32": {"REAL", "FLOAT", "REAL"},
+		"float64": {"DOUBLE PRECISION", "DOUBLE", "REAL"},
+		"bool":    {"BOOLEAN", "BOOLEAN", "BOOLEAN"},
+		"time":    {"TIMESTAMP", "DATETIME(3)", "DATETIME"},
+	}
+	return types[field.Kind][d.dialect()]
+}
+
+// IDColumn returns the definition of the id primary key column
+func (d *resourceData) IDColumn() string {
+	return [3]string{
+		"BIGSERIAL PRIMARY KEY",
+		"BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY",
+		"INTEGER PRIMARY KEY AUTOINCREMENT",
+	}[d.dialect()]
+}
+
+// TimestampType returns the SQL type of the created_at and updated_at columns
+func (d *resourceData) TimestampType() string {
+	return d.ColumnType(resource.Field{Kind: "time"})
+}
+
+// SqlcName returns the Go name sqlc gives a column, e.g. ID for id and
+// UnitPrice for unit_price
+func (d *resourceData) SqlcName(column string) string {
+	var b strings.Builder
+	for _, part := range strings.Split(column, "_") {
+		if part == "id" {
+			b.WriteString("ID")
+			continue
+		}
+		if part != "" {
+			b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+		}
+	}
+	return b.String()
+}
+
+// SqlcType returns the Go type sqlc generates for a field's NOT NULL column
+func (d *resourceData) SqlcType(field resource.Field) string {
+	types := map[string][3]string{
+		// postgresql, mysql, sqlite
+		"string":  {"string", "string", "string"},
+		"text":    {"string", "string", "string"},
+		"int":     {"int64", "int64", "int64"},
+		"int32":   {"int32", "int32", "int64"},
+		"int64":   {"int64", "int64", "int64"},
+		"uint":    {"int64", "uint64", "int64"},
+		"float32": {"float32", "float64", "float64"},
+		"float64": {"float64", "float64", "float64"},
+		"bool":    {"bool", "bool", "bool"},
+		"time":    {"time.Time", "time.Time", "time.Time"},
+	}
+	return types[field.Kind][d.dialect()]
+}
+
+// SqlcID returns the Go type sqlc generates for the id column
+func (d *resourceData) SqlcID() string {
+	if d.Database == config.DBMySQL {
+		return "uint64"
+	}
+	return "int64"
+}
+
+// SqlcArg returns the name sqlc gives the parameter of a query filtering
+// on column, e.g. unitPrice for unit_price
+func (d *resourceData) SqlcArg(column string) string {
+	name := d.SqlcName(column)
+	if strings.HasPrefix(name, "ID") {
+		name = "id" + name[2:]
+	} else {
+		name = strings.ToLower(name[:1]) + name[1:]
+	}
+	if token.IsKeyword(name) {
+		name += "_"
+	}
+	return name
+}
+
+// SqlcParam returns the model's field converted to its sqlc parameter type
+func (d *resourceData) SqlcParam(field resource.Field) string {
+	value := d.Resource.Var() + "." + field.Name
+	if goType := d.SqlcType(field); goType != field.GoType {
+		return goType + "(" + value + ")"
+	}
+	return value
+}
+
+// SqlcRow returns the field of a sqlc row converted to the model's type
+func (d *resourceData) SqlcRow(field resource.Field) string {
+	value := "row." + d.SqlcName(field.Column)
+	if d.SqlcType(field) != field.GoType {
+		return field.GoType + "(" + value + ")"
+	}
+	return value
+}
+
+// entAcronyms are written in upper case in the Go names ent generates
+var entAcronyms = map[string]bool{
+	"ACL": true, "API": true, "ASCII": true, "AWS": true, "CPU": true, "CSS": true, "DNS": true,
+	"EOF": true, "GB": true, "GUID": true, "HCL": true, "HTML": true, "HTTP": true, "HTTPS": true,
+	"ID": true, "IP": true, "JSON": true, "KB": true, "LHS": true, "MAC": true, "MB": true,
+	"QPS": true, "RAM": true, "RHS": true, "RPC": true, "SLA": true, "SMTP": true, "SQL": true,
+	"SSH": true, "SSO": true, "TCP": true, "TLS": true, "TTL": true, "UDP": true, "UI": true,
+	"UID": true, "URI": true, "URL": true, "UTF8": true, "UUID": true, "VM": true, "XML": true,
+	"XMPP": true, "XSRF": true, "XSS": true,
+}
+
+// EntName returns the Go name ent gives a field, e.g. UnitPrice for
+// unit_price, as used in its setters
+func (d *resourceData) EntName(column string) string {
+	var b strings.Builder
+	for _, part := range strings.Split(column, "_") {
+		if entAcronyms[strings.ToUpper(part)] {
+			b.WriteString(strings.ToUpper(part))
+			continue
+		}
+		if part != "" {
+			b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+		}
+	}
+	return b.String()
+}
+
+// EntField returns the ent schema definition of a field
+func (d *resourceData) EntField(field resource.Field) string {
+	builders := map[string]string{
+		"string":  "String",
+		"text":    "Text",
+		"int":     "Int",
+		"int32":   "Int32",
+		"int64":   "Int64",
+		"uint":    "Uint",
+		"float32": "Float32",
+		"float64": "Float",
+		"bool":    "Bool",
+		"time":    "Time",
+	}
+	definition := fmt.Sprintf("field.%s(%q)", builders[field.Kind], field.Column)
+	if field.Unique {
+		definition += ".Unique()"
+	}
+	if field.Private {
+		definition += ".Sensitive()"
+	}
+	return definition
+}
+
+// dialect indexes the per-database SQL types of ColumnType
+func (d *resourceData) dialect() int {
+	switch d.Database {
+	case config.DBMySQL:
+		return 1
+	case config.DBSQLite:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// ExampleJSON returns a request body with every field a request can set
+func (d *resourceData) ExampleJSON() string {
+	parts := make([]string, 0, len(d.Resource.Fields))
+	for _, field := range d.Resource.Fields {
+		if field.Private {
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%q: %s", field.Column, field.Example()))
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
@@ -0,0 +1,186 @@
+package manifest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/gool-cli/gool/internal/config"
+	"github.com/gool-cli/gool/internal/version"
+	"github.com/spf13/afero"
+	"gopkg.in/yaml.v3"
+)
+
+// FileName is the name of the manifest written into every generated project
+const FileName = ".gool.yaml"
+
+// BaseDir holds a pristine copy of every generated file. It is the common
+// ancestor used when merging template upgrades into edited files.
+const BaseDir = ".gool/base"
+
+// Manifest records the choices and output of a project generation
+type Manifest struct {
+	GoolVersion     string               `yaml:"gool_version"`
+	TemplateVersion string               `yaml:"template_version"`
+	Config          config.ProjectConfig `yaml:"config"`
+	Files           map[string]string    `yaml:"files"`
+	Resources       []Resource           `yaml:"resources,omitempty"`
+}
+
+// Resource records a resource added with gool generate resource
+type Resource struct {
+	Name   string   `yaml:"name"`
+	Fields []string `yaml:"fields"`
+}
+
+// New creates a manifest for the given configuration
+func New(cfg *config.ProjectConfig) *Manifest {
+	return &Manifest{
+		GoolVersion:     version.Version,
+		TemplateVersion: version.TemplateVersion,
+		Config:          *cfg,
+		Files:           make(map[string]string),
+	}
+}
+
+// Hash returns the content hash recorded for a generated file
+func Hash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// Load reads the manifest from a project directory
+func Load(fs afero.Fs, projectPath string) (*Manifest, error) {
+	content, err := afero.ReadFile(fs, filepath.Join(projectPath, FileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("no %s found in %s. Is this a gool project?", FileName, projectPath)
+		}
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+
+	m := &Manifest{}
+	if err := yaml.Unmarshal(content, m); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest: %w", err)
+	}
+	if m.Files == nil {
+		m.Files = make(map[string]string)
+	}
+
+	return m, nil
+}
+
+// Write saves the manifest into a project directory
+func (m *Manifest) Write(fs afero.Fs, projectPath string) error {
+	var buf bytes.Buffer
+	buf.WriteString("# Generated by gool. Records how this project was generated; do not edit by hand.\n")
+
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(m); err != nil {
+		return fmt.Errorf("failed to encode manifest: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("failed to encode manifest: %w", err)
+	}
+
+	if err := afero.WriteFile(fs, filepath.Join(projectPath, FileName), buf.Bytes(), 0644); err != nil {
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
+
+	return nil
+}
+
+// Paths returns the generated file paths in sorted order
+func (m *Manifest) Paths() []string {
+	paths := make([]string, 0, len(m.Files))
+	for path := range m.Files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// Record hashes the files at paths, relative to projectPath, and keeps a
+// pristine copy of each for later upgrades
+func (m *Manifest) Record(fs afero.Fs, projectPath string, paths []string) error {
+	for _, path := range paths {
+		content, err := afero.ReadFile(fs, filepath.Join(projectPath, filepath.FromSlash(path)))
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		m.Files[path] = Hash(content)
+		if err := WriteBase(fs, projectPath, path, content); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// HasResource reports whether a resource with the name was recorded
+func (m *Manifest) HasResource(name string) bool {
+	for _, res := range m.Resources {
+		if res.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ModifiedFiles returns the generated files whose content no longer matches
+// the recorded hash, including files that have been deleted
+func (m *Manifest) ModifiedFiles(fs afero.Fs, projectPath string) ([]string, error) {
+	var modified []string
+	for _, path := range m.Paths() {
+		content, err := afero.ReadFile(fs, filepath.Join(projectPath, filepath.FromSlash(path)))
+		if err != nil {
+			if os.IsNotExist(err) {
+				modified = append(modified, path)
+				continue
+			}
+			return nil, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		if Hash(content) != m.Files[path] {
+			modified = append(modified, path)
+		}
+	}
+	return modified, nil
+}
+
+// ReadBase returns the pristine generated content of a file, or nil if no
+// base copy exists
+func ReadBase(fs afero.Fs, projectPath, path string) ([]byte, error) {
+	content, err := afero.ReadFile(fs, filepath.Join(projectPath, BaseDir, filepath.FromSlash(path)))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read base copy of %s: %w", path, err)
+	}
+	return content, nil
+}
+
+// WriteBase stores the pristine generated content of a file
+func WriteBase(fs afero.Fs, projectPath, path string, content []byte) error {
+	basePath := filepath.Join(projectPath, BaseDir, filepath.FromSlash(path))
+	if err := fs.MkdirAll(filepath.Dir(basePath), 0755); err != nil {
+		return fmt.Errorf("failed to create directory %s: %w", filepath.Dir(basePath), err)
+	}
+	if err := afero.WriteFile(fs, basePath, content, 0644); err != nil {
+		return fmt.Errorf("failed to write base copy of %s: %w", path, err)
+	}
+	return nil
+}
+
+// RemoveBase deletes the pristine copy of a file that is no longer generated
+func RemoveBase(fs afero.Fs, projectPath, path string) error {
+	err := fs.Remove(filepath.Join(projectPath, BaseDir, filepath.FromSlash(path)))
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove base copy of %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+// Package plan describes the files a command would write, compared with what
+// is already on disk, so it can be previewed without writing anything.
+package plan
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/gool-cli/gool/internal/diff"
+	"github.com/gool-cli/gool/internal/manifest"
+	"github.com/spf13/afero"
+)
+
+// Change is what writing a file would do
+type Change string
+
+const (
+	// ChangeCreate means the file does not exist yet
+	ChangeCreate Change = "create"
+	// ChangeModify means the file exists with different content
+	ChangeModify Change = "modify"
+	// ChangeUnchanged means the file exists with the same content
+	ChangeUnchanged Change = "unchanged"
+)
+
+// File is a single file in a plan
+type File struct {
+	// Path is relative to the plan's root and uses forward slashes
+	Path   string
+	Size   int64
+	Change Change
+	// Diff is a unified diff against the existing file, set for ChangeModify
+	Diff string
+}
+
+// Build lists the files under root in planned and compares each one with
+// the file at the same path in existing. The pristine copies kept under the
+// manifest's base directory are bookkeeping and are left out.
+func Build(planned, existing afero.Fs, root string) ([]File, error) {
+	var files []File
+
+	err := afero.Walk(planned, root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if info.IsDir() {
+			if rel == manifest.BaseDir {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		content, err := afero.ReadFile(planned, path)
+		if err != nil {
+			return fmt.Errorf("failed to read planned %s: %w", rel, err)
+		}
+
+		file := File{Path: rel, Size: int64(len(content)), Change: ChangeCreate}
+		current, err := afero.ReadFile(existing, path)
+		switch {
+		case err == nil && bytes.Equal(current, content):
+			file.Change = ChangeUnchanged
+		case err == nil:
+			file.Change = ChangeModify
+			file.Diff = diff.Unified(string(current), string(content), "a/"+rel, "b/"+rel, 3)
+		case !os.IsNotExist(err):
+			return fmt.Errorf("failed to read %s: %w", rel, err)
+		}
+
+		files = append(files, file)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Path < files[j].Path
+	})
+	return files, nil
+}
+
+// Count returns the number of files with the given change
+func Count(files []File, change Change) int {
+	count := 0
+	for _, file := range files {
+		if file.Change == change {
+			count++
+		}
+	}
+	return count
+}
+
+// FormatSize returns a human readable file size
+func FormatSize(size int64) string {
+	switch {
+	case size < 1024:
+		return fmt.Sprintf("%d B", size)
+	case size < 1024*1024:
+		return strings.TrimSuffix(fmt.Sprintf("%.1f", float64(size)/1024), ".0") + " KB"
+	default:
+		return strings.TrimSuffix(fmt.Sprintf("%.1f", float64(size)/(1024*1024)), ".0") + " MB"
+	}
+}
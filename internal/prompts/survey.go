@@ -56,15 +56,14 @@ func CollectProjectConfig() (*config.ProjectConfig, error) {
 	cfg.Framework = extractFrameworkName(selectedFramework)
 
 	// ORM selection
+	ormOptions := make([]string, 0, len(config.ORMs))
+	for _, orm := range config.ORMs {
+		ormOptions = append(ormOptions, orm.Label())
+	}
 	ormPrompt := &survey.Select{
 		Message: "🗄️  Choose your database access layer:",
-		Options: []string{
-			fmt.Sprintf("🏗️  %s - Feature-rich ORM", config.ORMGorm),
-			fmt.Sprintf("⚙️  %s - Extensions on database/sql", config.ORMSqlx),
-			fmt.Sprintf("🔧 %s - Direct SQL queries", config.ORMRaw),
-			fmt.Sprintf("🚫 %s - No database", config.ORMNone),
-		},
-		Default: fmt.Sprintf("🏗️  %s - Feature-rich ORM", config.ORMGorm),
+		Options: ormOptions,
+		Default: ormOptions[0],
 		Help:    "Choose how you want to interact with your database",
 	}
 	var selectedORM string
@@ -75,16 +74,23 @@ func CollectProjectConfig() (*config.ProjectConfig, error) {
 
 	// Database selection (only if ORM is not none)
 	if cfg.ORM != config.ORMNone {
+		// Only the databases the access layer works with are offered
+		orm, _ := config.LookupORM(cfg.ORM)
+		var dbOptions []string
+		for _, option := range []string{
+			fmt.Sprintf("🐘 %s - Advanced open source database", config.DBPostgreSQL),
+			fmt.Sprintf("🐬 %s - Popular relational database", config.DBMySQL),
+			fmt.Sprintf("📁 %s - Lightweight file-based database", config.DBSQLite),
+			fmt.Sprintf("🍃 %s - NoSQL document database", config.DBMongoDB),
+			fmt.Sprintf("⚡ %s - In-memory data store", config.DBRedis),
+		} {
+			if orm.Supports(extractDBName(option)) {
+				dbOptions = append(dbOptions, option)
+			}
+		}
 		dbPrompt := &survey.Select{
 			Message: "💾 Choose your database:",
-			Options: []string{
-				fmt.Sprintf("🐘 %s - Advanced open source database", config.DBPostgreSQL),
-				fmt.Sprintf("🐬 %s - Popular relational database", config.DBMySQL),
-				fmt.Sprintf("📁 %s - Lightweight file-based database", config.DBSQLite),
-				fmt.Sprintf("🍃 %s - NoSQL document database", config.DBMongoDB),
-				fmt.Sprintf("⚡ %s - In-memory data store", config.DBRedis),
-				fmt.Sprintf("💾 %s - In-memory storage", config.DBMemory),
-			},
+			Options: dbOptions,
 			Default: fmt.Sprintf("🐘 %s - Advanced open source database", config.DBPostgreSQL),
 			Help:    "Select the database that matches your project requirements",
 		}
@@ -95,24 +101,47 @@ func CollectProjectConfig() (*config.ProjectConfig, error) {
 		cfg.Database = extractDBName(selectedDB)
 	}
 
-	// Architecture selection
-	archPrompt := &survey.Select{
-		Message: "🏛️  Choose your project architecture:",
-		Options: []string{
-			fmt.Sprintf("🎯 %s - Straightforward and easy to understand", config.ArchSimple),
-			fmt.Sprintf("🧹 %s - Clean Architecture with clear separation", config.ArchClean),
-			fmt.Sprintf("⬡ %s - Ports and Adapters pattern", config.ArchHexagonal),
-			fmt.Sprintf("🎨 %s - Model-View-Controller pattern", config.ArchMVC),
-			fmt.Sprintf("🔧 %s - Define your own structure", config.ArchCustom),
-		},
-		Default: fmt.Sprintf("🎯 %s - Straightforward and easy to understand", config.ArchSimple),
-		Help:    "Choose the architectural pattern that best fits your team and project",
-	}
-	var selectedArch string
-	if err := survey.AskOne(archPrompt, &selectedArch); err != nil {
-		return nil, err
+	// Architecture selection (revel projects keep revel's own layout)
+	if cfg.Framework == config.FrameworkRevel {
+		cfg.Architecture = config.ArchSimple
+	} else {
+		archPrompt := &survey.Select{
+			Message: "🏛️  Choose your project architecture:",
+			Options: []string{
+				fmt.Sprintf("🎯 %s - Straightforward and easy to understand", config.ArchSimple),
+				fmt.Sprintf("🧹 %s - Clean Architecture with clear separation", config.ArchClean),
+				fmt.Sprintf("⬡ %s - Ports and Adapters pattern", config.ArchHexagonal),
+				fmt.Sprintf("🎨 %s - Model-View-Controller pattern", config.ArchMVC),
+				fmt.Sprintf("🔧 %s - Define your own structure", config.ArchCustom),
+			},
+			Default: fmt.Sprintf("🎯 %s - Straightforward and easy to understand", config.ArchSimple),
+			Help:    "Choose the architectural pattern that best fits your team and project",
+		}
+		var selectedArch string
+		if err := survey.AskOne(archPrompt, &selectedArch); err != nil {
+			return nil, err
+		}
+		cfg.Architecture = extractArchName(selectedArch)
+
+		// A custom architecture can be described by a layout file
+		if cfg.Architecture == config.ArchCustom {
+			layoutPrompt := &survey.Input{
+				Message: "📐 Layout file (leave empty for a minimal structure):",
+				Help:    "A YAML file declaring your packages, the role each plays and the imports allowed between them",
+			}
+			var layoutFile string
+			if err := survey.AskOne(layoutPrompt, &layoutFile); err != nil {
+				return nil, err
+			}
+			if layoutFile != "" {
+				layout, err := config.LoadLayout(layoutFile)
+				if err != nil {
+					return nil, err
+				}
+				cfg.Layout = layout
+			}
+		}
 	}
-	cfg.Architecture = extractArchName(selectedArch)
 
 	// Configuration format
 	configPrompt := &survey.Select{
@@ -131,16 +160,21 @@ func CollectProjectConfig() (*config.ProjectConfig, error) {
 	}
 	cfg.Config = extractConfigName(selectedConfig)
 
-	// Authentication
+	// Authentication (revel projects sign in only with JWT)
+	authOptions := []string{
+		fmt.Sprintf("🎫 %s - JSON Web Tokens", config.AuthJWT),
+		fmt.Sprintf("🌐 %s - OAuth2 protocol", config.AuthOAuth2),
+		fmt.Sprintf("🔑 %s - Username and password", config.AuthBasic),
+		fmt.Sprintf("🗝️  %s - API keys for service-to-service calls", config.AuthAPIKey),
+		fmt.Sprintf("🚫 %s - No authentication", config.AuthNone),
+	}
+	if cfg.Framework == config.FrameworkRevel {
+		authOptions = []string{authOptions[0], authOptions[4]}
+	}
 	authPrompt := &survey.Select{
 		Message: "🔐 Choose your authentication method:",
-		Options: []string{
-			fmt.Sprintf("🎫 %s - JSON Web Tokens", config.AuthJWT),
-			fmt.Sprintf("🌐 %s - OAuth2 protocol", config.AuthOAuth2),
-			fmt.Sprintf("🔑 %s - Username and password", config.AuthBasic),
-			fmt.Sprintf("🚫 %s - No authentication", config.AuthNone),
-		},
-		Default: fmt.Sprintf("🎫 %s - JSON Web Tokens", config.AuthJWT),
+		Options: authOptions,
+		Default: authOptions[0],
 		Help:    "Choose how users will authenticate with your application",
 	}
 	var selectedAuth string
@@ -182,6 +216,9 @@ func CollectProjectConfig() (*config.ProjectConfig, error) {
 			"🌍 Internationalization (i18n) support",
 			"📊 Prometheus metrics",
 			"☁️  Cloud deployment configuration",
+			"🗃️  Database migrations (goose, embedded SQL)",
+			"🛡️  Role-based access control (RBAC)",
+			"🧩 Casbin policy backend for RBAC",
 		},
 		Help: "Select all the features you want to include in your project",
 	}
@@ -213,6 +250,12 @@ func CollectProjectConfig() (*config.ProjectConfig, error) {
 			cfg.Features.Metrics = true
 		case strings.Contains(feature, "Cloud"):
 			cfg.Features.CloudConfig = true
+		case strings.Contains(feature, "migrations"):
+			cfg.Features.Migrations = true
+		case strings.Contains(feature, "Role-based"):
+			cfg.Features.RBAC = true
+		case strings.Contains(feature, "Casbin"):
+			cfg.Features.Casbin = true
 		}
 	}
 
@@ -287,18 +330,12 @@ func extractFrameworkName(option string) string {
 }
 
 func extractORMName(option string) string {
-	switch {
-	case strings.Contains(option, config.ORMGorm):
-		return config.ORMGorm
-	case strings.Contains(option, config.ORMSqlx):
-		return config.ORMSqlx
-	case strings.Contains(option, config.ORMRaw):
-		return config.ORMRaw
-	case strings.Contains(option, config.ORMNone):
-		return config.ORMNone
-	default:
-		return config.ORMGorm
+	for _, orm := range config.ORMs {
+		if option == orm.Label() {
+			return orm.Name
+		}
 	}
+	return config.ORMGorm
 }
 
 func extractDBName(option string) string {
@@ -358,6 +395,8 @@ func extractAuthName(option string) string {
 		return config.AuthOAuth2
 	case strings.Contains(option, config.AuthBasic):
 		return config.AuthBasic
+	case strings.Contains(option, config.AuthAPIKey):
+		return config.AuthAPIKey
 	case strings.Contains(option, config.AuthNone):
 		return config.AuthNone
 	default:
@@ -0,0 +1,389 @@
+// Package resource parses resource definitions given to gool generate
+// resource and derives the names used in generated code.
+package resource
+
+import (
+	"fmt"
+	"go/token"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Field modifiers
+const (
+	ModifierUnique   = "unique"
+	ModifierIndex    = "index"
+	ModifierRequired = "required"
+	ModifierPrivate  = "private"
+)
+
+// goTypes maps the field types accepted on the command line to Go types
+var goTypes = map[string]string{
+	"string":  "string",
+	"text":    "string",
+	"int":     "int",
+	"int32":   "int32",
+	"int64":   "int64",
+	"uint":    "uint",
+	"float32": "float32",
+	"float64": "float64",
+	"bool":    "bool",
+	"time":    "time.Time",
+}
+
+// reservedFields are managed by the generated model itself
+var reservedFields = map[string]bool{
+	"id":         true,
+	"created_at": true,
+	"updated_at": true,
+}
+
+// initialisms are written in upper case in Go identifiers
+var initialisms = map[string]bool{
+	"api": true, "html": true, "http": true, "id": true, "ip": true, "json": true,
+	"sku": true, "sql": true, "uri": true, "url": true, "uuid": true,
+}
+
+var identPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+// Resource describes an entity to generate a CRUD slice for
+type Resource struct {
+	// Name is the exported Go name, e.g. OrderItem
+	Name   string
+	Fields []Field
+	// Internal resources are only used by the project's own code, so they
+	// get no service or handler, e.g. the API keys requests authenticate with
+	Internal bool
+	words    []string
+}
+
+// Field is a single attribute of a resource
+type Field struct {
+	// Name is the exported Go name, e.g. SKU
+	Name string
+	// Column is the snake_case name used for JSON and database columns
+	Column string
+	// Kind is the type given on the command line, e.g. float64
+	Kind string
+	// GoType is the Go type of the field, e.g. time.Time
+	GoType   string
+	Unique   bool
+	Index    bool
+	Required bool
+	// Private fields are stored but never read from or written to JSON, e.g.
+	// a password hash
+	Private bool
+}
+
+// Parse builds a resource from a name and field specs of the form
+// name:type[:modifier...]
+func Parse(name string, specs []string) (*Resource, error) {
+	if !identPattern.MatchString(name) {
+		return nil, fmt.Errorf("invalid resource name '%s': use letters, digits and underscores, starting with a letter", name)
+	}
+
+	words := splitWords(name)
+	r := &Resource{Name: camel(words, true), words: words}
+	if token.IsKeyword(r.Var()) {
+		return nil, fmt.Errorf("resource name '%s' is reserved", name)
+	}
+
+	seen := make(map[string]bool)
+	for _, spec := range specs {
+		field, err := parseField(spec)
+		if err != nil {
+			return nil, err
+		}
+		if seen[field.Column] {
+			return nil, fmt.Errorf("duplicate field '%s'", field.Column)
+		}
+		seen[field.Column] = true
+		r.Fields = append(r.Fields, field)
+	}
+	if len(r.Fields) == 0 {
+		return nil, fmt.Errorf("resource '%s' needs at least one field, e.g. name:string", r.Name)
+	}
+
+	return r, nil
+}
+
+func parseField(spec string) (Field, error) {
+	parts := strings.Split(spec, ":")
+	if len(parts) < 2 {
+		return Field{}, fmt.Errorf("invalid field '%s': expected name:type[:modifier]", spec)
+	}
+	if !identPattern.MatchString(parts[0]) {
+		return Field{}, fmt.Errorf("invalid field name '%s'", parts[0])
+	}
+
+	words := splitWords(parts[0])
+	field := Field{
+		Name:   camel(words, true),
+		Column: strings.Join(words, "_"),
+		Kind:   strings.ToLower(parts[1]),
+	}
+	if reservedFields[field.Column] {
+		return Field{}, fmt.Errorf("field '%s' is generated automatically", field.Column)
+	}
+
+	goType, ok := goTypes[field.Kind]
+	if !ok {
+		return Field{}, fmt.Errorf("invalid type '%s' for field '%s'. Valid types: %s", parts[1], parts[0], strings.Join(Types(), ", "))
+	}
+	field.GoType = goType
+
+	for _, modifier := range parts[2:] {
+		switch strings.ToLower(modifier) {
+		case ModifierUnique:
+			field.Unique = true
+		case ModifierIndex:
+			field.Index = true
+		case ModifierRequired:
+			field.Required = true
+		case ModifierPrivate:
+			field.Private = true
+		default:
+			return Field{}, fmt.Errorf("invalid modifier '%s' for field '%s'. Valid modifiers: %s",
+				modifier, parts[0], strings.Join(Modifiers(), ", "))
+		}
+	}
+	// Requests cannot set a private field, so they could never pass validation
+	if field.Private && field.Required {
+		return Field{}, fmt.Errorf("field '%s' cannot be both %s and %s", parts[0], ModifierPrivate, ModifierRequired)
+	}
+
+	return field, nil
+}
+
+// Types returns the accepted field types in sorted order
+func Types() []string {
+	types := make([]string, 0, len(goTypes))
+	for kind := range goTypes {
+		types = append(types, kind)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// Modifiers returns the accepted field modifiers
+func Modifiers() []string {
+	return []string{ModifierUnique, ModifierIndex, ModifierRequired, ModifierPrivate}
+}
+
+// Var is the unexported Go name, e.g. orderItem
+func (r *Resource) Var() string {
+	return camel(r.words, false)
+}
+
+// Plural is the exported plural Go name, e.g. OrderItems
+func (r *Resource) Plural() string {
+	return camel(r.pluralWords(), true)
+}
+
+// PluralVar is the unexported plural Go name, e.g. orderItems
+func (r *Resource) PluralVar() string {
+	return camel(r.pluralWords(), false)
+}
+
+// Table is the snake_case plural used for tables, e.g. order_items
+func (r *Resource) Table() string {
+	return strings.Join(r.pluralWords(), "_")
+}
+
+// Path is the URL path segment, e.g. order-items
+func (r *Resource) Path() string {
+	return strings.Join(r.pluralWords(), "-")
+}
+
+// Snake is the snake_case singular used for file names, e.g. order_item
+func (r *Resource) Snake() string {
+	return strings.Join(r.words, "_")
+}
+
+// Label is the lower case plural used in documentation, e.g. order items
+func (r *Resource) Label() string {
+	return strings.Join(r.pluralWords(), " ")
+}
+
+// HasTime reports whether any field is a time.Time
+func (r *Resource) HasTime() bool {
+	for _, field := range r.Fields {
+		if field.GoType == "time.Time" {
+			return true
+		}
+	}
+	return false
+}
+
+// HasRequired reports whether any field must be set
+func (r *Resource) HasRequired() bool {
+	for _, field := range r.Fields {
+		if field.Required {
+			return true
+		}
+	}
+	return false
+}
+
+// HasIndexes reports whether any field is unique or indexed
+func (r *Resource) HasIndexes() bool {
+	for _, field := range r.Fields {
+		if field.Unique || field.Index {
+			return true
+		}
+	}
+	return false
+}
+
+// UniqueFields returns the fields the repository can look a resource up by
+func (r *Resource) UniqueFields() []Field {
+	var fields []Field
+	for _, field := range r.Fields {
+		if field.Unique {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// PrivateFields returns the fields kept out of JSON
+func (r *Resource) PrivateFields() []Field {
+	var fields []Field
+	for _, field := range r.Fields {
+		if field.Private {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// IndexedFields returns the fields with an index that is not unique
+func (r *Resource) IndexedFields() []Field {
+	var fields []Field
+	for _, field := range r.Fields {
+		if field.Index && !field.Unique {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// Specs returns the field specs the resource was parsed from
+func (r *Resource) Specs() []string {
+	specs := make([]string, 0, len(r.Fields))
+	for _, field := range r.Fields {
+		spec := field.Column + ":" + field.Kind
+		if field.Unique {
+			spec += ":" + ModifierUnique
+		}
+		if field.Index {
+			spec += ":" + ModifierIndex
+		}
+		if field.Required {
+			spec += ":" + ModifierRequired
+		}
+		if field.Private {
+			spec += ":" + ModifierPrivate
+		}
+		specs = append(specs, spec)
+	}
+	return specs
+}
+
+func (r *Resource) pluralWords() []string {
+	words := append([]string(nil), r.words...)
+	words[len(words)-1] = plural(words[len(words)-1])
+	return words
+}
+
+// ZeroCheck returns a Go condition that is true when the field is unset on v
+func (f Field) ZeroCheck(v string) string {
+	switch f.GoType {
+	case "string":
+		return v + "." + f.Name + ` == ""`
+	case "bool":
+		return "!" + v + "." + f.Name
+	case "time.Time":
+		return v + "." + f.Name + ".IsZero()"
+	default:
+		return v + "." + f.Name + " == 0"
+	}
+}
+
+// Example returns a JSON literal used in tests and documentation
+func (f Field) Example() string {
+	switch f.GoType {
+	case "string":
+		return `"example ` + strings.ReplaceAll(f.Column, "_", " ") + `"`
+	case "bool":
+		return "true"
+	case "time.Time":
+		return `"2024-01-02T15:04:05Z"`
+	case "float32", "float64":
+		return "9.99"
+	default:
+		return "42"
+	}
+}
+
+// splitWords splits an identifier written in snake_case or CamelCase into
+// lower case words
+func splitWords(name string) []string {
+	var words []string
+	var current []rune
+	runes := []rune(name)
+	for i, r := range runes {
+		switch {
+		case r == '_':
+			if len(current) > 0 {
+				words = append(words, string(current))
+				current = nil
+			}
+			continue
+		case unicode.IsUpper(r) && len(current) > 0:
+			prevLower := unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1])
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if prevLower || (unicode.IsUpper(runes[i-1]) && nextLower) {
+				words = append(words, string(current))
+				current = nil
+			}
+		}
+		current = append(current, unicode.ToLower(r))
+	}
+	if len(current) > 0 {
+		words = append(words, string(current))
+	}
+	return words
+}
+
+// camel joins words into a Go identifier, upper casing initialisms
+func camel(words []string, exported bool) string {
+	var b strings.Builder
+	for i, word := range words {
+		if i == 0 && !exported {
+			b.WriteString(word)
+			continue
+		}
+		if initialisms[word] {
+			b.WriteString(strings.ToUpper(word))
+			continue
+		}
+		b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+	return b.String()
+}
+
+// plural returns the English plural of a lower case word
+func plural(word string) string {
+	switch {
+	case strings.HasSuffix(word, "s"), strings.HasSuffix(word, "x"), strings.HasSuffix(word, "z"),
+		strings.HasSuffix(word, "ch"), strings.HasSuffix(word, "sh"):
+		return word + "es"
+	case strings.HasSuffix(word, "y") && len(word) > 1 && !strings.ContainsRune("aeiou", rune(word[len(word)-2])):
+		return word[:len(word)-1] + "ies"
+	default:
+		return word + "s"
+	}
+}
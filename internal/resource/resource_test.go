@@ -0,0 +1,102 @@
+package resource
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseNames(t *testing.T) {
+	tests := []struct {
+		name       string
+		resource   string
+		wantName   string
+		wantVar    string
+		wantPlural string
+		wantTable  string
+		wantPath   string
+	}{
+		{name: "single word", resource: "product", wantName: "Product", wantVar: "product", wantPlural: "Products", wantTable: "products", wantPath: "products"},
+		{name: "camel case", resource: "OrderItem", wantName: "OrderItem", wantVar: "orderItem", wantPlural: "OrderItems", wantTable: "order_items", wantPath: "order-items"},
+		{name: "snake case", resource: "order_item", wantName: "OrderItem", wantVar: "orderItem", wantPlural: "OrderItems", wantTable: "order_items", wantPath: "order-items"},
+		{name: "initialism", resource: "APIKey", wantName: "APIKey", wantVar: "apiKey", wantPlural: "APIKeys", wantTable: "api_keys", wantPath: "api-keys"},
+		{name: "plural of a consonant and y", resource: "Category", wantName: "Category", wantVar: "category", wantPlural: "Categories", wantTable: "categories", wantPath: "categories"},
+		{name: "plural of a vowel and y", resource: "Survey", wantName: "Survey", wantVar: "survey", wantPlural: "Surveys", wantTable: "surveys", wantPath: "surveys"},
+		{name: "plural of s", resource: "Status", wantName: "Status", wantVar: "status", wantPlural: "Statuses", wantTable: "statuses", wantPath: "statuses"},
+		{name: "plural of x", resource: "Box", wantName: "Box", wantVar: "box", wantPlural: "Boxes", wantTable: "boxes", wantPath: "boxes"},
+		{name: "plural of ch", resource: "StoreBranch", wantName: "StoreBranch", wantVar: "storeBranch", wantPlural: "StoreBranches", wantTable: "store_branches", wantPath: "store-branches"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := Parse(tt.resource, []string{"name:string"})
+			if err != nil {
+				t.Fatalf("Parse failed: %v", err)
+			}
+			got := []string{r.Name, r.Var(), r.Plural(), r.Table(), r.Path()}
+			want := []string{tt.wantName, tt.wantVar, tt.wantPlural, tt.wantTable, tt.wantPath}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("expected %v, got %v", want, got)
+			}
+		})
+	}
+}
+
+func TestParseFields(t *testing.T) {
+	r, err := Parse("Order", []string{"order_id:uint:index", "customerEmail:string:required:unique", "APIKey:string:private", "placed_at:time"})
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	want := []Field{
+		{Name: "OrderID", Column: "order_id", Kind: "uint", GoType: "uint", Index: true},
+		{Name: "CustomerEmail", Column: "customer_email", Kind: "string", GoType: "string", Required: true, Unique: true},
+		{Name: "APIKey", Column: "api_key", Kind: "string", GoType: "string", Private: true},
+		{Name: "PlacedAt", Column: "placed_at", Kind: "time", GoType: "time.Time"},
+	}
+	if !reflect.DeepEqual(r.Fields, want) {
+		t.Errorf("expected %+v, got %+v", want, r.Fields)
+	}
+	if !r.HasTime() || !r.HasRequired() || !r.HasIndexes() {
+		t.Error("expected a time, a required and an indexed field")
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		resource string
+		specs    []string
+		wantErr  string
+	}{
+		{name: "invalid resource name", resource: "order-item", specs: []string{"name:string"}, wantErr: "invalid resource name"},
+		{name: "keyword resource name", resource: "Type", specs: []string{"name:string"}, wantErr: "is reserved"},
+		{name: "no fields", resource: "Product", wantErr: "needs at least one field"},
+		{name: "missing type", resource: "Product", specs: []string{"name"}, wantErr: "expected name:type"},
+		{name: "invalid field name", resource: "Product", specs: []string{"1name:string"}, wantErr: "invalid field name"},
+		{name: "duplicate field", resource: "Product", specs: []string{"name:string", "Name:text"}, wantErr: "duplicate field 'name'"},
+		{name: "reserved id", resource: "Product", specs: []string{"id:uint"}, wantErr: "generated automatically"},
+		{name: "reserved timestamp", resource: "Product", specs: []string{"CreatedAt:time"}, wantErr: "generated automatically"},
+		{name: "bad type", resource: "Product", specs: []string{"price:money"}, wantErr: "invalid type 'money'"},
+		{name: "bad modifier", resource: "Product", specs: []string{"name:string:primary"}, wantErr: "invalid modifier 'primary'"},
+		{name: "private and required", resource: "Product", specs: []string{"secret:string:private:required"}, wantErr: "cannot be both"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.resource, tt.specs)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected an error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestSpecsRoundTrip(t *testing.T) {
+	specs := []string{"name:string:unique:required", "order_id:uint:index", "password_hash:string:private"}
+	r, err := Parse("Order", specs)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if got := r.Specs(); !reflect.DeepEqual(got, specs) {
+		t.Errorf("expected %v, got %v", specs, got)
+	}
+}
@@ -1,25 +1,73 @@
 package templates
 
 import (
+	"bytes"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"strings"
 	"text/template"
 
 	"github.com/gool-cli/gool/internal/config"
+	"github.com/spf13/afero"
 )
 
 // Engine handles template processing
 type Engine struct {
-	templates map[string]*template.Template
+	// templates is the template tree, with the override directory layered
+	// over the built-in set
+	templates fs.FS
+	written   map[string][]byte
+	fs        afero.Fs
 }
 
-// NewEngine creates a new template engine
+// NewEngine creates a new template engine that writes to disk
 func NewEngine() *Engine {
+	return NewEngineFs(afero.NewOsFs())
+}
+
+// NewEngineFs creates a new template engine that writes to fs, such as an
+// in-memory filesystem for dry runs
+func NewEngineFs(fs afero.Fs) *Engine {
 	return &Engine{
-		templates: make(map[string]*template.Template),
+		written: make(map[string][]byte),
+		fs:      fs,
+	}
+}
+
+// Fs returns the filesystem the engine writes to
+func (e *Engine) Fs() afero.Fs {
+	return e.fs
+}
+
+// UseTemplates sets the built-in template tree. Templates in the override
+// directory, if one is set, take precedence over it.
+func (e *Engine) UseTemplates(builtin fs.FS) {
+	e.templates = builtin
+	if overrideDir != "" {
+		e.templates = Overlay(os.DirFS(overrideDir), builtin)
+	}
+}
+
+// Templates returns the template tree the engine reads from
+func (e *Engine) Templates() fs.FS {
+	return e.templates
+}
+
+// ReadTemplate returns the named template, from the override directory
+// when it has one and otherwise from the built-in set
+func (e *Engine) ReadTemplate(name string) (string, error) {
+	content, err := fs.ReadFile(e.templates, name)
+	if err != nil {
+		return "", fmt.Errorf("failed to read template %s: %w", name, err)
 	}
+	return string(content), nil
+}
+
+// Written returns the content of every file written by the engine, keyed by path
+func (e *Engine) Written() map[string][]byte {
+	return e.written
 }
 
 // getFuncMap returns template functions
@@ -39,46 +87,63 @@ func (e *Engine) getFuncMap() template.FuncMap {
 
 // RenderToFile renders a template to a file
 func (e *Engine) RenderToFile(templateContent, filePath string, data interface{}) error {
-	// Create directory if it doesn't exist
-	dir := filepath.Dir(filePath)
-	if err := os.MkdirAll(dir, 0755); err != nil {
-		return fmt.Errorf("failed to create directory %s: %w", dir, err)
+	content, err := e.Render(templateContent, data)
+	if err != nil {
+		return err
 	}
 
+	return e.WriteFile(filePath, content)
+}
+
+// Render renders a template to a string
+func (e *Engine) Render(templateContent string, data interface{}) (string, error) {
+	return e.RenderWithPartials(templateContent, nil, data)
+}
+
+// RenderWithPartials renders a template to a string. The template can
+// include any of the partials, keyed by name, with {{template "name" .}}.
+func (e *Engine) RenderWithPartials(templateContent string, partials map[string]string, data interface{}) (string, error) {
 	// Parse template with custom functions
 	tmpl, err := template.New("template").Funcs(e.getFuncMap()).Parse(templateContent)
 	if err != nil {
-		return fmt.Errorf("failed to parse template: %w", err)
+		return "", fmt.Errorf("failed to parse template: %w", err)
 	}
-
-	// Create file
-	file, err := os.Create(filePath)
-	if err != nil {
-		return fmt.Errorf("failed to create file %s: %w", filePath, err)
+	for name, content := range partials {
+		if _, err := tmpl.New(name).Parse(content); err != nil {
+			return "", fmt.Errorf("failed to parse partial %s: %w", name, err)
+		}
 	}
-	defer file.Close()
 
 	// Execute template
-	if err := tmpl.Execute(file, data); err != nil {
-		return fmt.Errorf("failed to execute template: %w", err)
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to execute template: %w", err)
 	}
 
-	return nil
+	return buf.String(), nil
 }
 
 // WriteFile writes content directly to a file
 func (e *Engine) WriteFile(filePath, content string) error {
 	// Create directory if it doesn't exist
-	dir := filepath.Dir(filePath)
-	if err := os.MkdirAll(dir, 0755); err != nil {
-		return fmt.Errorf("failed to create directory %s: %w", dir, err)
+	if err := e.MkdirAll(filepath.Dir(filePath)); err != nil {
+		return err
 	}
 
 	// Write file
-	if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+	if err := afero.WriteFile(e.fs, filePath, []byte(content), 0644); err != nil {
 		return fmt.Errorf("failed to write file %s: %w", filePath, err)
 	}
 
+	e.written[filePath] = []byte(content)
+	return nil
+}
+
+// MkdirAll creates a directory and its parents
+func (e *Engine) MkdirAll(dir string) error {
+	if err := e.fs.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create directory %s: %w", dir, err)
+	}
 	return nil
 }
 
@@ -102,4 +167,29 @@ func NewTemplateData(cfg *config.ProjectConfig) *TemplateData {
 		ORM:         cfg.ORM,
 		Database:    cfg.Database,
 	}
+}
+
+// ORMModules returns the go.mod requirements of the project's database access
+// layer
+func (d *TemplateData) ORMModules() []string {
+	orm, _ := config.LookupORM(d.ORM)
+	return orm.Modules(d.Database, d.Config.Testing, d.Config.PureGoSQLite())
+}
+
+// SQLiteImport returns the import path of the project's database/sql SQLite
+// driver
+func (d *TemplateData) SQLiteImport() string {
+	if d.Config.PureGoSQLite() {
+		return "modernc.org/sqlite"
+	}
+	return "github.com/mattn/go-sqlite3"
+}
+
+// SQLiteDriver returns the name the project's SQLite driver registers with
+// database/sql
+func (d *TemplateData) SQLiteDriver() string {
+	if d.Config.PureGoSQLite() {
+		return "sqlite"
+	}
+	return "sqlite3"
 } 
\ No newline at end of file
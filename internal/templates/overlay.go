@@ -0,0 +1,85 @@
+package templates
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// overrideDir is the directory searched for templates before the built-in set
+var overrideDir string
+
+// SetOverrideDir sets the directory searched for templates before the
+// built-in set. Files in it replace the built-in template with the same
+// name; an empty dir disables overrides.
+func SetOverrideDir(dir string) {
+	overrideDir = dir
+}
+
+// OverrideDir returns the directory set with SetOverrideDir
+func OverrideDir() string {
+	return overrideDir
+}
+
+// DefaultOverrideDir returns ~/.gool/templates, where overrides are read
+// from when no directory is given
+func DefaultOverrideDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".gool", "templates"), nil
+}
+
+// Overlay returns a filesystem that opens each file from the first layer
+// that has it. Directory listings merge the entries of every layer.
+func Overlay(layers ...fs.FS) fs.FS {
+	return overlay(layers)
+}
+
+type overlay []fs.FS
+
+func (o overlay) Open(name string) (fs.File, error) {
+	for _, layer := range o {
+		f, err := layer.Open(name)
+		if err == nil {
+			return f, nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+	}
+	return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+}
+
+func (o overlay) ReadDir(name string) ([]fs.DirEntry, error) {
+	seen := make(map[string]bool)
+	var entries []fs.DirEntry
+	found := false
+	for _, layer := range o {
+		layerEntries, err := fs.ReadDir(layer, name)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return nil, err
+		}
+		found = true
+		for _, entry := range layerEntries {
+			if !seen[entry.Name()] {
+				seen[entry.Name()] = true
+				entries = append(entries, entry)
+			}
+		}
+	}
+	if !found {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrNotExist}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name() < entries[j].Name()
+	})
+	return entries, nil
+}
@@ -0,0 +1,243 @@
+package upgrade
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/gool-cli/gool/internal/astpatch"
+	"github.com/gool-cli/gool/internal/config"
+	"github.com/gool-cli/gool/internal/diff"
+	"github.com/gool-cli/gool/internal/generator"
+	"github.com/gool-cli/gool/internal/manifest"
+	"github.com/gool-cli/gool/internal/resource"
+	"github.com/gool-cli/gool/internal/version"
+	"github.com/spf13/afero"
+	"golang.org/x/mod/modfile"
+)
+
+// AddableFeatures lists the features that can be added to an existing project
+var AddableFeatures = []string{
+	config.FeatureMetrics,
+	config.FeatureWebSocket,
+	config.FeatureCaching,
+	config.FeatureSwagger,
+	config.FeatureI18n,
+	config.FeatureStaticFiles,
+}
+
+// AddFeatures enables features in the project at projectPath in fs. Files
+// the features introduce are written, and files they change are patched in
+// place: go.mod through its requirements, Go files along their syntax tree
+// and everything else with a three-way merge.
+func AddFeatures(fs afero.Fs, projectPath string, names []string) (*Report, error) {
+	previous, err := manifest.Load(fs, projectPath)
+	if err != nil {
+		return nil, err
+	}
+	if previous.TemplateVersion != version.TemplateVersion {
+		return nil, fmt.Errorf("project uses templates v%s but gool has v%s. Run gool upgrade first",
+			previous.TemplateVersion, version.TemplateVersion)
+	}
+	if previous.Config.Framework == config.FrameworkRevel {
+		return nil, fmt.Errorf("adding features is not supported for revel projects")
+	}
+
+	cfg := previous.Config
+	for _, name := range names {
+		if !isAddableFeature(name) {
+			return nil, fmt.Errorf("feature '%s' cannot be added. Valid options: %s", name, strings.Join(AddableFeatures, ", "))
+		}
+		if err := cfg.Features.Enable(name); err != nil {
+			return nil, err
+		}
+	}
+	if cfg.Features == previous.Config.Features {
+		return nil, fmt.Errorf("%s already enabled", strings.Join(names, ", "))
+	}
+
+	before, err := render(&previous.Config, previous.Resources)
+	if err != nil {
+		return nil, err
+	}
+
+	after, err := render(&cfg, previous.Resources)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{
+		FromVersion: previous.TemplateVersion,
+		ToVersion:   version.TemplateVersion,
+	}
+	labels := diff.Labels{
+		Ours:   "yours",
+		Base:   "generated",
+		Theirs: "gool (" + strings.Join(names, ", ") + ")",
+	}
+
+	next := manifest.New(&cfg)
+	next.Resources = previous.Resources
+	for _, path := range after.manifest.Paths() {
+		next.Files[path] = after.manifest.Files[path]
+
+		hash, existed := before.manifest.Files[path]
+		if existed && hash == after.manifest.Files[path] {
+			continue
+		}
+
+		content, err := after.read(path)
+		if err != nil {
+			return nil, err
+		}
+		var base []byte
+		if existed {
+			if base, err = before.read(path); err != nil {
+				return nil, err
+			}
+		}
+
+		result, err := patchFile(fs, projectPath, path, base, content, labels)
+		if err != nil {
+			return nil, err
+		}
+		report.Files = append(report.Files, result)
+
+		if err := manifest.WriteBase(fs, projectPath, path, content); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := next.Write(fs, projectPath); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+func isAddableFeature(name string) bool {
+	for _, feature := range AddableFeatures {
+		if strings.EqualFold(strings.TrimSpace(name), feature) {
+			return true
+		}
+	}
+	return false
+}
+
+// renderRoot is where projects are rendered inside their in-memory filesystem
+const renderRoot = "project"
+
+// rendering is a project generated into memory
+type rendering struct {
+	fs       afero.Fs
+	manifest *manifest.Manifest
+}
+
+// render generates a project into an in-memory filesystem, along with the
+// resources added to it by gool generate resource, in the order they were
+// added
+func render(cfg *config.ProjectConfig, resources []manifest.Resource) (*rendering, error) {
+	fs := afero.NewMemMapFs()
+	gen := generator.NewFs(fs)
+	if err := gen.GenerateAt(cfg, renderRoot); err != nil {
+		return nil, fmt.Errorf("failed to render templates: %w", err)
+	}
+
+	m, err := manifest.Load(fs, renderRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, recorded := range resources {
+		// The project's starter resources are generated with it
+		if m.HasResource(recorded.Name) {
+			continue
+		}
+		res, err := resource.Parse(recorded.Name, recorded.Fields)
+		if err != nil {
+			return nil, fmt.Errorf("invalid resource %s in manifest: %w", recorded.Name, err)
+		}
+		written, err := gen.GenerateResource(cfg, renderRoot, res)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render resource %s: %w", res.Name, err)
+		}
+		if err := m.Record(fs, renderRoot, written); err != nil {
+			return nil, err
+		}
+	}
+
+	return &rendering{fs: fs, manifest: m}, nil
+}
+
+// read returns the content of a rendered file
+func (r *rendering) read(path string) ([]byte, error) {
+	content, err := afero.ReadFile(r.fs, filepath.Join(renderRoot, filepath.FromSlash(path)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rendered %s: %w", path, err)
+	}
+	return content, nil
+}
+
+// patchFile applies the change from base to rendered to a locally edited
+// file in place, falling back to a three-way merge when it cannot
+func patchFile(fs afero.Fs, projectPath, path string, base, rendered []byte, labels diff.Labels) (FileResult, error) {
+	target := filepath.Join(projectPath, filepath.FromSlash(path))
+
+	current, err := afero.ReadFile(fs, target)
+	if err == nil && base != nil && !bytes.Equal(current, base) && !bytes.Equal(current, rendered) {
+		var patched []byte
+		var patchErr error
+		switch {
+		case path == "go.mod":
+			patched, patchErr = patchGoMod(current, base, rendered)
+		case strings.HasSuffix(path, ".go"):
+			patched, patchErr = astpatch.Apply(current, base, rendered)
+		}
+
+		if patched != nil && patchErr == nil {
+			if err := afero.WriteFile(fs, target, patched, 0644); err != nil {
+				return FileResult{Path: path}, fmt.Errorf("failed to write %s: %w", path, err)
+			}
+			return FileResult{Path: path, Status: StatusPatched}, nil
+		}
+	}
+
+	return mergeFile(fs, projectPath, path, base, rendered, labels)
+}
+
+// patchGoMod adds the requirements rendered gained over base to current
+func patchGoMod(current, base, rendered []byte) ([]byte, error) {
+	cur, err := modfile.Parse("go.mod", current, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse go.mod: %w", err)
+	}
+	old, err := modfile.Parse("go.mod", base, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse go.mod: %w", err)
+	}
+	next, err := modfile.Parse("go.mod", rendered, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse go.mod: %w", err)
+	}
+
+	known := make(map[string]bool)
+	for _, req := range old.Require {
+		known[req.Mod.Path] = true
+	}
+	requires := make([]*modfile.Require, 0, len(cur.Require))
+	for _, req := range cur.Require {
+		known[req.Mod.Path] = true
+		requires = append(requires, req)
+	}
+	for _, req := range next.Require {
+		if !known[req.Mod.Path] {
+			requires = append(requires, req)
+		}
+	}
+
+	cur.SetRequireSeparateIndirect(requires)
+	cur.Cleanup()
+
+	return cur.Format()
+}
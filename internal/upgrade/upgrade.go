@@ -0,0 +1,192 @@
+package upgrade
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/gool-cli/gool/internal/diff"
+	"github.com/gool-cli/gool/internal/manifest"
+	"github.com/gool-cli/gool/internal/version"
+	"github.com/spf13/afero"
+)
+
+// Status describes what an upgrade did to a single file
+type Status string
+
+const (
+	// StatusUpdated means the file was untouched locally and now has the new render
+	StatusUpdated Status = "updated"
+	// StatusMerged means local edits and template changes were merged cleanly
+	StatusMerged Status = "merged"
+	// StatusPatched means a locally edited file was patched in place
+	StatusPatched Status = "patched"
+	// StatusConflict means the file was written with conflict markers
+	StatusConflict Status = "conflict"
+	// StatusAdded means the file is new in this template version
+	StatusAdded Status = "added"
+	// StatusUnchanged means the file did not need to change
+	StatusUnchanged Status = "unchanged"
+	// StatusSkipped means the file was deleted locally and was left deleted
+	StatusSkipped Status = "skipped"
+	// StatusRemoved means the file is no longer generated and was left in place
+	StatusRemoved Status = "removed"
+)
+
+// FileResult is the outcome of upgrading a single file
+type FileResult struct {
+	Path      string
+	Status    Status
+	Conflicts int
+}
+
+// Report summarises an upgrade
+type Report struct {
+	FromVersion string
+	ToVersion   string
+	Files       []FileResult
+}
+
+// Count returns the number of files with the given status
+func (r *Report) Count(status Status) int {
+	count := 0
+	for _, file := range r.Files {
+		if file.Status == status {
+			count++
+		}
+	}
+	return count
+}
+
+// Run re-renders the project at projectPath in fs with the current templates
+// and merges the result into the working tree. The pristine copy of the
+// previous render is the common ancestor of each three-way merge.
+func Run(fs afero.Fs, projectPath string) (*Report, error) {
+	previous, err := manifest.Load(fs, projectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := previous.Config
+	rendered, err := render(&cfg, previous.Resources)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{
+		FromVersion: previous.TemplateVersion,
+		ToVersion:   version.TemplateVersion,
+	}
+	labels := diff.Labels{
+		Ours:   "yours",
+		Base:   "generated (templates v" + previous.TemplateVersion + ")",
+		Theirs: "gool (templates v" + version.TemplateVersion + ")",
+	}
+
+	next := manifest.New(&cfg)
+	next.Resources = previous.Resources
+	for _, path := range rendered.manifest.Paths() {
+		content, err := rendered.read(path)
+		if err != nil {
+			return nil, err
+		}
+
+		base, err := baseContent(fs, projectPath, path, previous)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := mergeFile(fs, projectPath, path, base, content, labels)
+		if err != nil {
+			return nil, err
+		}
+		report.Files = append(report.Files, result)
+
+		if err := manifest.WriteBase(fs, projectPath, path, content); err != nil {
+			return nil, err
+		}
+		next.Files[path] = rendered.manifest.Files[path]
+	}
+
+	// Files that are no longer generated stay in the project
+	for _, path := range previous.Paths() {
+		if _, ok := next.Files[path]; ok {
+			continue
+		}
+		report.Files = append(report.Files, FileResult{Path: path, Status: StatusRemoved})
+		if err := manifest.RemoveBase(fs, projectPath, path); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := next.Write(fs, projectPath); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// baseContent returns the pristine copy of a file from the previous render
+func baseContent(fs afero.Fs, projectPath, path string, previous *manifest.Manifest) ([]byte, error) {
+	base, err := manifest.ReadBase(fs, projectPath, path)
+	if err != nil || base != nil {
+		return base, err
+	}
+
+	// Without a pristine copy, an untouched file still matches its recorded hash
+	current, err := afero.ReadFile(fs, filepath.Join(projectPath, filepath.FromSlash(path)))
+	if err == nil && previous.Files[path] == manifest.Hash(current) {
+		return current, nil
+	}
+	return nil, nil
+}
+
+// mergeFile brings a single file up to date with its new render using a
+// three-way merge against base, its previous render
+func mergeFile(fs afero.Fs, projectPath, path string, base, rendered []byte, labels diff.Labels) (FileResult, error) {
+	result := FileResult{Path: path}
+	target := filepath.Join(projectPath, filepath.FromSlash(path))
+
+	current, err := afero.ReadFile(fs, target)
+	exists := err == nil
+	if err != nil && !os.IsNotExist(err) {
+		return result, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	var content []byte
+	switch {
+	case !exists && base == nil:
+		result.Status = StatusAdded
+		content = rendered
+	case !exists:
+		result.Status = StatusSkipped
+		return result, nil
+	case bytes.Equal(current, rendered):
+		result.Status = StatusUnchanged
+		return result, nil
+	case bytes.Equal(current, base):
+		result.Status = StatusUpdated
+		content = rendered
+	case bytes.Equal(rendered, base):
+		result.Status = StatusUnchanged
+		return result, nil
+	default:
+		merged := diff.Merge3(string(base), string(current), string(rendered), labels)
+		result.Status = StatusMerged
+		if merged.Conflicts > 0 {
+			result.Status = StatusConflict
+			result.Conflicts = merged.Conflicts
+		}
+		content = []byte(merged.Text)
+	}
+
+	if err := fs.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return result, fmt.Errorf("failed to create directory for %s: %w", path, err)
+	}
+	if err := afero.WriteFile(fs, target, content, 0644); err != nil {
+		return result, fmt.Errorf("failed to write %s: %w", path, err)
+	}
+
+	return result, nil
+}
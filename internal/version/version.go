@@ -0,0 +1,8 @@
+package version
+
+// Version is the gool release version
+const Version = "1.0.0"
+
+// TemplateVersion identifies the built-in template set. Bump it whenever a
+// template change alters generated output so projects can be upgraded.
+const TemplateVersion = "2"